@@ -1,10 +1,17 @@
 package main
 
 import (
+	"context"
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/repository"
 	"event-management-backend/internal/routes"
+	"event-management-backend/internal/scheduler"
 	"event-management-backend/internal/seeders"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/services/loginlimit"
+	"event-management-backend/internal/services/sms"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/services/waitlist"
 	"event-management-backend/migrations"
 	"fmt"
 	"log"
@@ -27,6 +34,9 @@ func main() {
 	seeders.SeedRBAC(config.DB)
 	seeders.SeedRoleWages(config.DB)
 
+	// Background jobs
+	startScheduler(context.Background())
+
 	// Router
 	router := gin.Default()
 	router.RedirectTrailingSlash = false
@@ -40,15 +50,40 @@ func main() {
 			"message": "status ok",
 		})
 	})
+	// Login throttling, in memory for a single instance. Per-IP limits are
+	// on when config.LimitByIP says the client address can be trusted.
+	ipPolicy := loginlimit.Policy{}
+	if config.LimitByIP() {
+		ipPolicy = loginlimit.DefaultIPPolicy
+	}
+	loginLimiter := loginlimit.NewLimiter(
+		loginlimit.NewMemoryStore(),
+		loginlimit.DefaultPhonePolicy,
+		ipPolicy,
+	)
+
+	// OTP codes and reset tokens go out by SMS
+	smsSender, err := sms.NewSenderFromEnv()
+	if err != nil {
+		log.Fatal("SMS setup failed:", err)
+	}
+
 	// Auth routes
 	routes.AuthRoutes(
 		api,
 		repository.NewUserRepository(),
 		repository.NewRefreshTokenRepository(),
+		repository.NewWageChangeLogRepository(),
+		repository.NewSecurityEventRepository(),
+		repository.NewOTPRepository(),
+		repository.NewPasswordResetRepository(),
+		repository.NewAuthRequestRepository(),
+		smsSender,
+		loginLimiter,
 	)
 
 	// Protected routes
-	routes.AdminRoutes(api)
+	routes.AdminRoutes(api, loginLimiter)
 	routes.CaptainRoutes(api)
 	routes.WorkerRoutes(api)
 
@@ -62,3 +97,44 @@ func main() {
 	log.Printf("🚀 Server running at http://localhost%s", addr)
 	router.Run(addr)
 }
+
+func startScheduler(ctx context.Context) {
+	eventRepo := repository.NewEventRepository()
+	bookingRepo := repository.NewBookingRepository()
+	userRepo := repository.NewUserRepository()
+	lifecycleRepo := repository.NewEventLifecycleLogRepository()
+	settingRepo := repository.NewSettingRepository()
+	revisionRepo := repository.NewRoleWageRevisionRepository()
+	overrideRepo := repository.NewWageOverrideRepository()
+	wageResolver := wages.NewWageResolver(
+		revisionRepo,
+		repository.NewWageTierRepository(),
+		repository.NewWageChangeLogRepository(),
+		overrideRepo,
+	)
+
+	waitlistService := waitlist.NewWaitlistService(
+		repository.NewWaitlistRepository(), bookingRepo, eventRepo, userRepo, wageResolver,
+	)
+	eventService := admin.NewAdminEventService(eventRepo, waitlistService, lifecycleRepo, wageResolver)
+
+	scheduler.NewEventLifecycleScheduler(
+		eventRepo,
+		bookingRepo,
+		settingRepo,
+		lifecycleRepo,
+		eventService,
+	).Start(ctx)
+
+	scheduler.NewRoleWageScheduler(
+		settingRepo,
+		admin.NewRoleWageService(repository.NewRoleWageRepository(), userRepo, revisionRepo, wageResolver),
+		admin.NewWageOverrideService(overrideRepo, userRepo, wageResolver),
+	).Start(ctx)
+
+	scheduler.NewSessionCleanupScheduler(
+		settingRepo,
+		repository.NewRefreshTokenRepository(),
+		repository.NewAuthRequestRepository(),
+	).Start(ctx)
+}
@@ -11,18 +11,7 @@ import (
 
 func SetupWebConfig(r *gin.Engine) {
 
-	originsEnv := os.Getenv("CORS_ORIGINS")
-
-	var origins []string
-	if originsEnv != "" {
-		parts := strings.Split(originsEnv, ",")
-		for _, o := range parts {
-			o = strings.TrimSpace(o)
-			if o != "" {
-				origins = append(origins, o)
-			}
-		}
-	}
+	origins := envList("CORS_ORIGINS")
 
 	r.Use(cors.New(cors.Config{
 		AllowOrigins: origins,
@@ -38,5 +27,36 @@ func SetupWebConfig(r *gin.Engine) {
 		MaxAge:           12 * time.Hour,
 	}))
 
-	r.SetTrustedProxies(nil)
+	// TRUSTED_PROXIES lists the load balancers (IPs or CIDRs) in front of
+	// the app. Only their X-Forwarded-For is believed; without it
+	// c.ClientIP() is the address of whoever connected.
+	r.SetTrustedProxies(envList("TRUSTED_PROXIES"))
+}
+
+// LimitByIP reports whether login, OTP and password reset limits also apply
+// per client IP. It is on when TRUSTED_PROXIES is set, since client
+// addresses are real then; RATE_LIMIT_BY_IP=true or false overrides that,
+// e.g. true when clients reach the app without a proxy. Behind a proxy
+// that is not listed every request carries the proxy's address and one
+// client's failures would throttle all users.
+func LimitByIP() bool {
+	switch os.Getenv("RATE_LIMIT_BY_IP") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return len(envList("TRUSTED_PROXIES")) > 0
+}
+
+// envList splits a comma separated variable, nil when unset.
+func envList(name string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
 }
\ No newline at end of file
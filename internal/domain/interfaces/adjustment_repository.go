@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type AdjustmentRuleRepository interface {
+	Create(rule *models.AdjustmentRule) error
+	Update(rule *models.AdjustmentRule) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.AdjustmentRule, error)
+	ListAll(activeOnly bool) ([]models.AdjustmentRule, error)
+	FindActiveByIDsTx(tx *gorm.DB, ids []uint) ([]models.AdjustmentRule, error)
+}
+
+type BookingAdjustmentRepository interface {
+	ListByBookingTx(tx *gorm.DB, bookingID uint) ([]models.BookingAdjustment, error)
+	CreateTx(tx *gorm.DB, items []models.BookingAdjustment) error
+	// DeleteByBookingTx removes rule lines, manual lines, or both.
+	// TA claims are only removed together with everything else.
+	DeleteByBookingTx(tx *gorm.DB, bookingID uint, rules, manual bool) error
+	DeleteKindTx(tx *gorm.DB, bookingID uint, kind string) error
+	FindByIDForUpdateTx(tx *gorm.DB, id uint) (*models.BookingAdjustment, error)
+	UpdateTx(tx *gorm.DB, item *models.BookingAdjustment) error
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type AdvanceRepository interface {
+	CreateTx(tx *gorm.DB, advance *models.Advance) error
+	UpdateTx(tx *gorm.DB, advance *models.Advance) error
+	FindByID(id uint) (*models.Advance, error)
+	FindForUpdate(tx *gorm.DB, id uint) (*models.Advance, error)
+	List(userID uint, status string) ([]models.Advance, error)
+	// ListActiveForUpdate locks the active advances of a user, oldest first.
+	ListActiveForUpdate(tx *gorm.DB, userID uint) ([]models.Advance, error)
+	CreateRepaymentTx(tx *gorm.DB, repayment *models.AdvanceRepayment) error
+	Outstanding(userID uint) (int64, error)
+}
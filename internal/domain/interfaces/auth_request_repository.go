@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+)
+
+type AuthRequestRepository interface {
+	Create(request *models.AuthRequest) error
+	CountByPhoneSince(kind, phone string, since time.Time) (int64, error)
+	CountByIPSince(kind, ip string, since time.Time) (int64, error)
+	// LatestByPhone returns when the phone last asked, nil when never.
+	LatestByPhone(kind, phone string) (*time.Time, error)
+	// PurgeBefore hard-deletes requests older than the cutoff.
+	PurgeBefore(before time.Time) (int64, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type BookingCancellationRepository interface {
+	CreateTx(tx *gorm.DB, cancellation *models.BookingCancellation) error
+}
@@ -10,8 +10,10 @@ type BookingRepository interface {
 	Create(booking *models.Booking) error
 	FindByID(id uint) (*models.Booking, error)
 	FindByEventAndUser(eventID uint, userID uint) (*models.Booking, error)
+	FindByEventAndUserTx(tx *gorm.DB, eventID uint, userID uint) (*models.Booking, error)
 	ListByUser(userID uint) ([]models.Booking, error)
 	ListByEvent(eventID uint) ([]models.Booking, error)
+	ListByPayout(payoutID uint) ([]models.Booking, error)
 	FindByIDForUpdate(tx *gorm.DB, id uint) (*models.Booking, error)
 	Update(booking *models.Booking) error
 	DeleteTx(tx *gorm.DB, id uint) error
@@ -0,0 +1,7 @@
+package interfaces
+
+import "event-management-backend/internal/domain/models"
+
+type CheckInAttemptRepository interface {
+	Create(attempt *models.CheckInAttempt) error
+}
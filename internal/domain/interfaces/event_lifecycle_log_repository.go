@@ -0,0 +1,9 @@
+package interfaces
+
+import "event-management-backend/internal/domain/models"
+
+type EventLifecycleLogRepository interface {
+	Create(log *models.EventLifecycleLog) error
+	Exists(eventID uint, action string) (bool, error)
+	List(eventID uint, action string) ([]models.EventLifecycleLog, error)
+}
@@ -10,16 +10,16 @@ import (
 
 type EventRepository interface {
 	Create(event *models.Event) error
+	CreateTx(tx *gorm.DB, event *models.Event) error
 	Update(event *models.Event) error
 	FindByID(id uint) (*models.Event, error)
 	FindByIDForUpdate(tx *gorm.DB, id uint) (*models.Event, error)
 	ListAll(status string, date string) ([]models.Event, error)
 
+	SaveTx(tx *gorm.DB, event *models.Event) error
+
 	// ---- ROLE BASED AVAILABILITY (EXCLUDES ALREADY BOOKED EVENTS) ----
-	ListAvailableForCaptain(userID uint, fromDate time.Time) ([]models.Event, error)
-	ListAvailableForSubCaptain(userID uint, fromDate time.Time) ([]models.Event, error)
-	ListAvailableForMainBoy(userID uint, fromDate time.Time) ([]models.Event, error)
-	ListAvailableForJunior(userID uint, fromDate time.Time) ([]models.Event, error)
+	ListAvailableForRole(userID uint, role string, fromDate time.Time) ([]models.Event, error)
 
 	SoftDelete(id uint) error
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type EventSeriesRepository interface {
+	CreateTx(tx *gorm.DB, series *models.EventSeries) error
+	FindByID(id uint) (*models.EventSeries, error)
+	ListAll(status string) ([]models.EventSeries, error)
+	UpdateTx(tx *gorm.DB, series *models.EventSeries) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import "event-management-backend/internal/domain/models"
+
+type EventTemplateRepository interface {
+	Create(template *models.EventTemplate) error
+	FindByID(id uint) (*models.EventTemplate, error)
+	// NameTaken reports whether another live template uses the name.
+	NameTaken(name string, excludeID uint) (bool, error)
+	ListAll() ([]models.EventTemplate, error)
+	Update(template *models.EventTemplate) error
+	Delete(id uint) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type OTPRepository interface {
+	// Create retires any earlier unused code for the phone.
+	Create(code *models.OTPCode) error
+	// FindActiveForUpdateTx locks the newest unused code for the phone.
+	FindActiveForUpdateTx(tx *gorm.DB, phone string) (*models.OTPCode, error)
+	UpdateTx(tx *gorm.DB, code *models.OTPCode) error
+}
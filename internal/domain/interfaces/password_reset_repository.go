@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type PasswordResetRepository interface {
+	// Create retires any earlier unused token of the user.
+	Create(token *models.PasswordResetToken) error
+	FindByHashedForUpdateTx(tx *gorm.DB, hashed string) (*models.PasswordResetToken, error)
+	MarkUsedTx(tx *gorm.DB, id uint) error
+}
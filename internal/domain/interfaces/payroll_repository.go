@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type PayrollRepository interface {
+	// ---- PERIODS ----
+	CreatePeriod(period *models.PayrollPeriod) error
+	FindPeriodByID(id uint) (*models.PayrollPeriod, error)
+	FindPeriodForUpdate(tx *gorm.DB, id uint) (*models.PayrollPeriod, error)
+	ListPeriods(status string) ([]models.PayrollPeriod, error)
+	HasOverlap(start, end time.Time) (bool, error)
+	UpdatePeriodTx(tx *gorm.DB, period *models.PayrollPeriod) error
+
+	// ---- PAYOUTS ----
+	CreatePayoutTx(tx *gorm.DB, payout *models.Payout) error
+	ListPayouts(periodID uint, status string) ([]models.Payout, error)
+	FindPayoutByID(id uint) (*models.Payout, error)
+	FindPayoutByPeriodAndUser(periodID, userID uint) (*models.Payout, error)
+	FindPayoutForUpdate(tx *gorm.DB, id uint) (*models.Payout, error)
+	UpdatePayoutTx(tx *gorm.DB, payout *models.Payout) error
+}
@@ -1,10 +1,29 @@
 package interfaces
 
-import "event-management-backend/internal/domain/models"
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
 
 type RefreshTokenRepository interface {
+	// Save starts a new family when FamilyID is zero.
 	Save(token *models.RefreshToken) error
-	FindByUserID(userID uint) (*models.RefreshToken, error)
 	FindByHashedToken(hashed string) (*models.RefreshToken, error)
+	FindByHashedTokenForUpdateTx(tx *gorm.DB, hashed string) (*models.RefreshToken, error)
+	// RotateTx saves next and retires old in its favour.
+	RotateTx(tx *gorm.DB, old, next *models.RefreshToken) error
+	// ListActiveByUserID returns the live token of each unexpired session,
+	// most recently used first.
+	ListActiveByUserID(userID uint) ([]models.RefreshToken, error)
+	// DeleteFamily removes one session of the user, retired tokens included.
+	DeleteFamily(userID, familyID uint) error
 	DeleteByUserID(userID uint) error
+	// DeleteOtherFamilies removes every session of the user but keepFamilyID.
+	DeleteOtherFamilies(userID, keepFamilyID uint) error
+	// PurgeStale hard-deletes tokens that expired, or were revoked, before
+	// the cutoff and returns how many rows went.
+	PurgeStale(before time.Time) (int64, error)
 }
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type RoleWageRevisionRepository interface {
+	CreateTx(tx *gorm.DB, revision *models.RoleWageRevision) error
+	FindByID(id uint) (*models.RoleWageRevision, error)
+	FindByRoleAndDate(role string, effectiveFrom time.Time) (*models.RoleWageRevision, error)
+	ListByRole(role string) ([]models.RoleWageRevision, error)
+	Delete(id uint) error
+
+	// FindEffectiveTx returns the revision in force for role on date.
+	FindEffectiveTx(tx *gorm.DB, role string, date time.Time) (*models.RoleWageRevision, error)
+	// ListDue returns unapplied revisions effective on or before date.
+	ListDue(date time.Time) ([]models.RoleWageRevision, error)
+	UpdateTx(tx *gorm.DB, revision *models.RoleWageRevision) error
+}
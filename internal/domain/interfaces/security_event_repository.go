@@ -0,0 +1,8 @@
+package interfaces
+
+import "event-management-backend/internal/domain/models"
+
+type SecurityEventRepository interface {
+	Create(event *models.SecurityEvent) error
+	List(userID uint, kind string) ([]models.SecurityEvent, error)
+}
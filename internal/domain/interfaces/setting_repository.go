@@ -0,0 +1,9 @@
+package interfaces
+
+import "event-management-backend/internal/domain/models"
+
+type SettingRepository interface {
+	FindByKey(key string) (*models.SystemSetting, error)
+	GetInt(key string, fallback int64) int64
+	GetBool(key string, fallback bool) bool
+}
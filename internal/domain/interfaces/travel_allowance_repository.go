@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type StartingPointRepository interface {
+	Create(point *models.StartingPoint) error
+	Update(point *models.StartingPoint) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.StartingPoint, error)
+	// FindByNameTx matches name case-insensitively.
+	FindByNameTx(tx *gorm.DB, name string) (*models.StartingPoint, error)
+	ListAll() ([]models.StartingPoint, error)
+}
+
+type TAPolicyRepository interface {
+	Create(policy *models.TAPolicy) error
+	Update(policy *models.TAPolicy) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.TAPolicy, error)
+	FindByTransportTx(tx *gorm.DB, transportType string) (*models.TAPolicy, error)
+	ListAll() ([]models.TAPolicy, error)
+}
@@ -1,10 +1,15 @@
 package interfaces
 
-import "event-management-backend/internal/domain/models"
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
 
 type UserRepository interface {
 	Create(user *models.User) error
 	FindByID(id uint) (*models.User, error)
+	FindByIDs(ids []uint) ([]models.User, error)
 	FindByPhone(phone string) (*models.User, error)
 	ListAll(role string, status string) ([]models.User, error)
 	FindAll() ([]models.User, error)
@@ -14,10 +19,19 @@ type UserRepository interface {
     SearchByPhone(phone string) ([]models.User, error)
 
 	Update(user *models.User) error
-	UpdateRole(user *models.User) error
+	// UpdateRoleTx saves Role and AdminRoleID; the wage is left to the
+	// wage resolver.
+	UpdateRoleTx(tx *gorm.DB, user *models.User) error
 	UpdateFields(id uint, updates map[string]interface{}) error
 	UpdateWageByRole(role string, wage int64) error
     
 	RemovePhoto(id uint) error
 	SoftDelete(id uint) error
+
+	// FindTokenState loads only the fields the auth middleware checks.
+	FindTokenState(id uint) (*models.User, error)
+	BumpTokenVersion(id uint) error
+	BumpTokenVersionByAdminRole(adminRoleID uint) error
+	// SetPasswordTx also bumps the token version.
+	SetPasswordTx(tx *gorm.DB, id uint, hashed string) error
 }
\ No newline at end of file
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type WageOverrideRepository interface {
+	// FindByUser returns the override of userID that has not expired yet.
+	FindByUser(userID uint) (*models.WageOverride, error)
+	// FindActiveTx returns the override of userID in force at t.
+	FindActiveTx(tx *gorm.DB, userID uint, t time.Time) (*models.WageOverride, error)
+	// ListActiveByUsersTx returns the overrides of the users in force at t,
+	// latest start first.
+	ListActiveByUsersTx(tx *gorm.DB, userIDs []uint, t time.Time) ([]models.WageOverride, error)
+	SaveTx(tx *gorm.DB, override *models.WageOverride) error
+	// ExpireTx ends the unexpired override of userID at the given time and
+	// marks it released. The row is kept.
+	ExpireTx(tx *gorm.DB, userID uint, at time.Time) error
+	// ListExpired returns expired overrides not yet released.
+	ListExpired(now time.Time) ([]models.WageOverride, error)
+	MarkReleasedTx(tx *gorm.DB, id uint, at time.Time) error
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type WageTierRepository interface {
+	CreateTx(tx *gorm.DB, tier *models.WageTier) error
+	UpdateTx(tx *gorm.DB, tier *models.WageTier) error
+	DeleteTx(tx *gorm.DB, id uint) error
+	FindByID(id uint) (*models.WageTier, error)
+	ListAll(role string) ([]models.WageTier, error)
+
+	// ListByRoleTx returns the tiers of role, highest threshold first.
+	ListByRoleTx(tx *gorm.DB, role string) ([]models.WageTier, error)
+}
+
+type WageChangeLogRepository interface {
+	CreateTx(tx *gorm.DB, entry *models.WageChangeLog) error
+	ListByUser(userID uint, limit int) ([]models.WageChangeLog, error)
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type WaitlistRepository interface {
+	CreateTx(tx *gorm.DB, entry *models.Waitlist) error
+	FindByEventAndUser(eventID uint, userID uint) (*models.Waitlist, error)
+	FindByEventAndUserTx(tx *gorm.DB, eventID uint, userID uint) (*models.Waitlist, error)
+	// ListByUser returns the waiting entries of the user for events still
+	// open for booking.
+	ListByUser(userID uint) ([]models.Waitlist, error)
+	CountAhead(entry *models.Waitlist) (int64, error)
+	Delete(id uint) error
+	DeleteTx(tx *gorm.DB, id uint) error
+
+	ListWaitingForUpdate(tx *gorm.DB, eventID uint, role string) ([]models.Waitlist, error)
+	UpdateTx(tx *gorm.DB, entry *models.Waitlist) error
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	AdjustmentKindFine  = "fine"
+	AdjustmentKindBonus = "bonus"
+	// A TA entered by a captain above the approval threshold. It only
+	// replaces the booking TA once approved.
+	AdjustmentKindTA = "ta"
+
+	AdjustmentStatusApproved = "approved"
+	AdjustmentStatusPending  = "pending"
+	AdjustmentStatusRejected = "rejected"
+
+	ManualAdjustmentName = "manual adjustment"
+	TAClaimName          = "travel allowance"
+)
+
+// AdjustmentRule is a named fine or bonus from the catalogue captains pick
+// from when marking attendance.
+type AdjustmentRule struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	Name        string         `gorm:"size:150;not null" json:"name"`
+	Kind        string         `gorm:"size:10;not null;index" json:"kind"`
+	Amount      int64          `gorm:"not null" json:"amount"`
+	Description string         `gorm:"size:255" json:"description"`
+	Active      bool           `gorm:"default:true" json:"active"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// BookingAdjustment is one fine or bonus line on a booking. Name and amount
+// are copied from the rule so later catalogue edits do not change history.
+// RuleID is nil for manual amounts entered by admins.
+// Only approved lines count towards the booking amounts.
+type BookingAdjustment struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	BookingID  uint       `gorm:"index;not null" json:"booking_id"`
+	RuleID     *uint      `gorm:"index" json:"rule_id"`
+	Name       string     `gorm:"size:150;not null" json:"name"`
+	Kind       string     `gorm:"size:10;not null" json:"kind"`
+	Amount     int64      `gorm:"not null" json:"amount"`
+	Status     string     `gorm:"size:10;not null;default:'approved';index" json:"status"`
+	CreatedBy  *uint      `json:"created_by"`
+	ReviewedBy *uint      `json:"reviewed_by"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
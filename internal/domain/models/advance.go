@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+const (
+	AdvanceStatusActive     = "active"
+	AdvanceStatusSettled    = "settled"
+	AdvanceStatusWrittenOff = "written_off"
+
+	// Taken from a booking when payroll is closed.
+	RepaymentSourceDeduction = "deduction"
+	// Paid back in cash and recorded by an admin.
+	RepaymentSourceManual = "manual"
+)
+
+// Advance is cash paid to a user ahead of their wages. The balance is
+// deducted from completed bookings when payroll is closed, at most
+// PerEventCap from one booking and InstallmentAmount per payroll period
+// (zero means no limit).
+type Advance struct {
+	ID                uint       `gorm:"primaryKey" json:"id"`
+	UserID            uint       `gorm:"index;not null" json:"user_id"`
+	Amount            int64      `gorm:"not null" json:"amount"`
+	Balance           int64      `gorm:"not null" json:"balance"`
+	PerEventCap       int64      `gorm:"default:0" json:"per_event_cap"`
+	InstallmentAmount int64      `gorm:"default:0" json:"installment_amount"`
+	Status            string     `gorm:"size:20;default:'active';index" json:"status"`
+	Note              string     `gorm:"size:255" json:"note"`
+	IssuedAt          time.Time  `gorm:"not null" json:"issued_at"`
+	SettledAt         *time.Time `json:"settled_at"`
+	CreatedBy         *uint      `json:"created_by"`
+
+	User       User               `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Repayments []AdvanceRepayment `gorm:"foreignKey:AdvanceID" json:"repayments,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AdvanceRepayment is one entry reducing an advance balance.
+type AdvanceRepayment struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	AdvanceID uint      `gorm:"index;not null" json:"advance_id"`
+	UserID    uint      `gorm:"index;not null" json:"user_id"`
+	BookingID *uint     `gorm:"index" json:"booking_id"`
+	PeriodID  *uint     `gorm:"index" json:"period_id"`
+	Source    string    `gorm:"size:20;not null" json:"source"`
+	Amount    int64     `gorm:"not null" json:"amount"`
+	Note      string    `gorm:"size:255" json:"note"`
+	CreatedBy *uint     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+const (
+	AuthRequestOTP           = "otp"
+	AuthRequestPasswordReset = "password_reset"
+)
+
+// AuthRequest records one request for a login code or reset token. Rows are
+// written before the phone is looked up so limits apply to registered and
+// unknown numbers alike.
+type AuthRequest struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Kind      string    `gorm:"size:30;not null;index:idx_auth_requests_phone,priority:1;index:idx_auth_requests_ip,priority:1" json:"kind"`
+	Phone     string    `gorm:"size:30;not null;index:idx_auth_requests_phone,priority:2" json:"phone"`
+	IP        string    `gorm:"size:64;index:idx_auth_requests_ip,priority:2" json:"ip"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+}
@@ -11,8 +11,16 @@ const (
 	BookingStatusPresent   = "present"
 	BookingStatusAbsent    = "absent"
 	BookingStatusCompleted = "completed"
+
+	CheckInMethodQR     = "qr"
+	CheckInMethodManual = "manual"
+	CheckInMethodGeo    = "geo"
 )
 
+// WorkedHoursColumn selects hours between check-in and check-out, rounded to
+// two places, as worked_hours. Zero until the booking is checked out.
+const WorkedHoursColumn = `COALESCE(ROUND(CAST(EXTRACT(EPOCH FROM (bookings.checked_out_at - bookings.checked_in_at)) / 3600 AS numeric), 2), 0) AS worked_hours`
+
 type Booking struct {
 	ID          uint           `gorm:"primaryKey" json:"id"`
 	
@@ -28,10 +36,47 @@ type Booking struct {
 	BonusAmount int64          `gorm:"default:0" json:"bonus_amount"`
 	FineAmount  int64          `gorm:"default:0" json:"fine_amount"`
 	TotalAmount int64          `gorm:"default:0" json:"total_amount"`
+	// advance recovered when payroll was closed, already taken off TotalAmount
+	AdvanceDeduction int64     `gorm:"default:0" json:"advance_deduction"`
+	
+	TAPolicyID   *uint         `json:"ta_policy_id"`
+	TADistanceKm *float64      `json:"ta_distance_km"`
+	TARule       string        `gorm:"size:255" json:"ta_rule"`      // how the TA was decided
+	TAOverridden bool          `gorm:"default:false" json:"ta_overridden"` // entered by hand
+	
+	CheckedInAt   *time.Time   `json:"checked_in_at"`
+	CheckedOutAt  *time.Time   `json:"checked_out_at"`
+	CheckInMethod string       `gorm:"size:20" json:"check_in_method"`
+	
+	PayoutID    *uint          `gorm:"index" json:"payout_id"` // set once payroll is closed
 	
 	Event       Event          `gorm:"foreignKey:EventID" json:"event"`
+	Payout      *Payout        `gorm:"foreignKey:PayoutID" json:"-"`
 
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
-}
\ No newline at end of file
+}
+
+// PaymentStatus reports whether the booking has been paid out.
+// The Payout relation must be preloaded.
+func (b *Booking) PaymentStatus() (string, *time.Time) {
+	if b.Payout != nil && b.Payout.Status == PayoutStatusPaid {
+		return PaymentStatusPaid, b.Payout.PaidAt
+	}
+	return PaymentStatusUnpaid, nil
+}
+
+// RecalcTotal sets TotalAmount from the amount fields: base, extra wage, TA
+// and bonus less fines, never below zero. Absent bookings earn nothing.
+func (b *Booking) RecalcTotal() {
+	if b.Status == BookingStatusAbsent {
+		b.TotalAmount = 0
+		return
+	}
+
+	b.TotalAmount = b.BaseAmount + b.ExtraAmount + b.TAAmount + b.BonusAmount - b.FineAmount
+	if b.TotalAmount < 0 {
+		b.TotalAmount = 0
+	}
+}
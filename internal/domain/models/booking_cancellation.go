@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type BookingCancellation struct {
+	ID                     uint      `gorm:"primaryKey" json:"id"`
+	BookingID              uint      `gorm:"not null" json:"booking_id"`
+	EventID                uint      `gorm:"not null;index" json:"event_id"`
+	UserID                 uint      `gorm:"not null;index" json:"user_id"`
+	Role                   string    `gorm:"size:50;not null" json:"role"`
+	MinutesBeforeReporting int64     `json:"minutes_before_reporting"`
+	IsLate                 bool      `gorm:"default:false;index" json:"is_late"`
+	CancelledAt            time.Time `gorm:"not null" json:"cancelled_at"`
+	CreatedAt              time.Time `json:"created_at"`
+}
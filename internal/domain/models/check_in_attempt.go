@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// CheckInAttempt logs every geofenced self check-in, accepted or not, so
+// admins can review workers who tried to check in from elsewhere.
+type CheckInAttempt struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	BookingID      uint      `gorm:"not null;index" json:"booking_id"`
+	EventID        uint      `gorm:"not null;index" json:"event_id"`
+	UserID         uint      `gorm:"not null;index" json:"user_id"`
+	Latitude       float64   `json:"latitude"`
+	Longitude      float64   `json:"longitude"`
+	DistanceMeters float64   `json:"distance_meters"`
+	RadiusMeters   uint      `json:"radius_meters"`
+	Accepted       bool      `gorm:"index" json:"accepted"`
+	Reason         string    `gorm:"size:255" json:"reason"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -28,24 +28,76 @@ type Event struct {
 	ReportingTime        string         `gorm:"size:10;not null" json:"reporting_time"`
 	WorkType             string         `gorm:"size:50;not null" json:"work_type"`
 	LocationLink         string         `gorm:"size:255" json:"location_link"`
+	Latitude             *float64       `json:"latitude"`
+	Longitude            *float64       `json:"longitude"`
+	RadiusMeters         uint           `gorm:"default:0" json:"radius_meters"` // 0 = use default setting
 	
-	RequiredCaptains     uint           `gorm:"default:0" json:"required_captains"`
-	RequiredSubCaptains  uint           `gorm:"default:0" json:"required_sub_captains"`
-	RequiredMainBoys     uint           `gorm:"default:0" json:"required_main_boys"`
-	RequiredJuniors      uint           `gorm:"default:0" json:"required_juniors"`
-	
-	RemainingCaptains    uint           `gorm:"not null;default:0" json:"remaining_captains"`
-	RemainingSubCaptains uint           `gorm:"not null;default:0" json:"remaining_sub_captains"`
-	RemainingMainBoys    uint           `gorm:"not null;default:0" json:"remaining_main_boys"`
-	RemainingJuniors     uint           `gorm:"not null;default:0" json:"remaining_juniors"`
+	RoleRequirements     []EventRoleRequirement `gorm:"foreignKey:EventID;constraint:OnDelete:CASCADE" json:"role_requirements"`
 	
 	LongWork             bool           `gorm:"default:false" json:"long_work"`
 	TransportProvided    bool           `gorm:"default:false" json:"transport_provided"`
 	TransportType        string         `gorm:"size:20" json:"transport_type"`
 	ExtraWageAmount      int64          `gorm:"default:0" json:"extra_wage_amount"`
 	
+	SeriesID             *uint          `gorm:"index" json:"series_id"`
+	
 	Status               string         `gorm:"size:20;default:'upcoming';index" json:"status"`
 	CreatedAt            time.Time      `json:"created_at"`
 	UpdatedAt            time.Time      `json:"updated_at"`
 	DeletedAt            gorm.DeletedAt `gorm:"index" json:"-"`
-}
\ No newline at end of file
+}
+
+var reportingTimeLayouts = []string{"15:04", "15:04:05", "3:04 PM", "03:04 PM", "3:04PM", "03:04PM"}
+
+// HasLocation reports whether the venue coordinates are known.
+func (e *Event) HasLocation() bool {
+	return e.Latitude != nil && e.Longitude != nil
+}
+
+// ReportingAt combines the event date with its reporting time.
+// Falls back to the start of the event day if the time cannot be parsed.
+func (e *Event) ReportingAt() time.Time {
+	y, m, d := e.Date.In(time.Local).Date()
+	for _, layout := range reportingTimeLayouts {
+		if t, err := time.Parse(layout, e.ReportingTime); err == nil {
+			return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, time.Local)
+		}
+	}
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+// Requirement returns the staffing row for the role, or nil if the event
+// does not need that role.
+func (e *Event) Requirement(role string) *EventRoleRequirement {
+	for i := range e.RoleRequirements {
+		if e.RoleRequirements[i].Role == role {
+			return &e.RoleRequirements[i]
+		}
+	}
+	return nil
+}
+
+// RemainingFor returns the open slots for the role.
+func (e *Event) RemainingFor(role string) uint {
+	if req := e.Requirement(role); req != nil {
+		return req.Remaining
+	}
+	return 0
+}
+
+// TakeSlot books one slot for the role. Returns false when none are left.
+func (e *Event) TakeSlot(role string) bool {
+	req := e.Requirement(role)
+	if req == nil || req.Remaining == 0 {
+		return false
+	}
+	req.Remaining--
+	return true
+}
+
+// ReleaseSlot gives a booked slot for the role back to the event.
+func (e *Event) ReleaseSlot(role string) {
+	if req := e.Requirement(role); req != nil && req.Remaining < req.Required {
+		req.Remaining++
+	}
+}
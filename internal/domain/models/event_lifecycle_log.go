@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+const (
+	LifecycleActionAutoStart    = "auto_start"
+	LifecycleActionAutoComplete = "auto_complete"
+	LifecycleActionAutoCancel   = "auto_cancel"
+	LifecycleActionOverdue      = "overdue_flagged"
+)
+
+// EventLifecycleLog records every status change (or warning) made by the
+// background scheduler rather than by an admin or captain.
+type EventLifecycleLog struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	EventID    uint      `gorm:"not null;index" json:"event_id"`
+	Action     string    `gorm:"size:30;not null;index" json:"action"`
+	FromStatus string    `gorm:"size:20" json:"from_status"`
+	ToStatus   string    `gorm:"size:20" json:"to_status"`
+	Reason     string    `gorm:"size:255" json:"reason"`
+	Event      Event     `gorm:"foreignKey:EventID" json:"event,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// EventRoleRequirement holds how many staff of one role an event needs and
+// how many of those slots are still open.
+type EventRoleRequirement struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	EventID   uint      `gorm:"not null;uniqueIndex:idx_event_role" json:"event_id"`
+	Role      string    `gorm:"size:50;not null;uniqueIndex:idx_event_role" json:"role"`
+	Required  uint      `gorm:"not null;default:0" json:"required"`
+	Remaining uint      `gorm:"not null;default:0" json:"remaining"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Booked returns how many slots of the role are taken.
+func (r *EventRoleRequirement) Booked() uint {
+	return r.Required - r.Remaining
+}
+
+// RoleLabel turns a role slug into readable text, e.g. "main_boy" -> "main boy".
+func RoleLabel(role string) string {
+	return strings.ReplaceAll(role, "_", " ")
+}
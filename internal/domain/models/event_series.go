@@ -0,0 +1,152 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+
+	SeriesStatusActive    = "active"
+	SeriesStatusCancelled = "cancelled"
+
+	// Edit scopes for series occurrences
+	SeriesScopeThis      = "this"
+	SeriesScopeFollowing = "following"
+	SeriesScopeAll       = "all"
+
+	MaxSeriesOccurrences = 180
+)
+
+// EventSeries describes a recurring gig. Its occurrences are ordinary events
+// linked back through Event.SeriesID.
+type EventSeries struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	Name      string     `gorm:"size:200;not null" json:"name"`
+	Frequency string     `gorm:"size:20;not null" json:"frequency"`
+	Interval  uint       `gorm:"not null;default:1" json:"interval"`
+	Weekdays  string     `gorm:"size:30" json:"weekdays"` // weekly only, e.g. "sat,sun"
+	StartDate time.Time  `gorm:"not null" json:"start_date"`
+	UntilDate *time.Time `json:"until_date"`
+	Count     uint       `gorm:"default:0" json:"count"`
+	Status    string     `gorm:"size:20;default:'active';index" json:"status"`
+
+	Events []Event `gorm:"foreignKey:SeriesID" json:"events,omitempty"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func ValidWeekday(code string) bool {
+	_, ok := weekdayCodes[code]
+	return ok
+}
+
+// Occurrences expands the recurrence rule into event dates, starting at
+// StartDate and stopping at UntilDate or after Count dates.
+func (s *EventSeries) Occurrences() ([]time.Time, error) {
+	if s.UntilDate == nil && s.Count == 0 {
+		return nil, errors.New("series needs an until date or a count")
+	}
+
+	interval := int(s.Interval)
+	if interval == 0 {
+		interval = 1
+	}
+
+	start := s.StartDate
+	dates := make([]time.Time, 0)
+
+	done := func(d time.Time) bool {
+		if s.UntilDate != nil && d.After(*s.UntilDate) {
+			return true
+		}
+		return s.Count > 0 && uint(len(dates)) >= s.Count
+	}
+
+	add := func(d time.Time) error {
+		if len(dates) >= MaxSeriesOccurrences {
+			return errors.New("series would create too many events")
+		}
+		dates = append(dates, d)
+		return nil
+	}
+
+	switch s.Frequency {
+	case RecurrenceDaily:
+		for d := start; !done(d); d = d.AddDate(0, 0, interval) {
+			if err := add(d); err != nil {
+				return nil, err
+			}
+		}
+
+	case RecurrenceWeekly:
+		days := map[time.Weekday]bool{}
+		for _, code := range strings.Split(s.Weekdays, ",") {
+			if wd, ok := weekdayCodes[strings.TrimSpace(code)]; ok {
+				days[wd] = true
+			}
+		}
+		if len(days) == 0 {
+			days[start.Weekday()] = true
+		}
+
+		weekStart := start.AddDate(0, 0, -int(start.Weekday()))
+		for d := start; !done(d); d = d.AddDate(0, 0, 1) {
+			week := daysBetween(weekStart, d) / 7
+			if week%interval != 0 || !days[d.Weekday()] {
+				continue
+			}
+			if err := add(d); err != nil {
+				return nil, err
+			}
+		}
+
+	case RecurrenceMonthly:
+		for k := 0; ; k += interval {
+			d := start.AddDate(0, k, 0)
+			if done(d) {
+				break
+			}
+			// skip months without this day (e.g. the 31st)
+			if d.Day() != start.Day() {
+				continue
+			}
+			if err := add(d); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, errors.New("invalid recurrence frequency")
+	}
+
+	return dates, nil
+}
+
+// daysBetween counts calendar days, so a 23 or 25 hour day around a DST
+// change still counts as one.
+func daysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	start := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	end := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours()) / 24
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// EventTemplate keeps the details admins repeat for similar events so a new
+// event only needs a date.
+type EventTemplate struct {
+	ID                uint   `gorm:"primaryKey" json:"id"`
+	// unique among live templates only, so a deleted name can be reused
+	Name              string `gorm:"size:100;not null;uniqueIndex:idx_event_templates_name_live,where:deleted_at IS NULL" json:"name"`
+	EventName         string `gorm:"size:200" json:"event_name"`
+	TimeSlot          string `gorm:"size:20" json:"time_slot"`
+	ReportingTime     string `gorm:"size:10" json:"reporting_time"`
+	WorkType          string `gorm:"size:50" json:"work_type"`
+	LocationLink      string `gorm:"size:255" json:"location_link"`
+	LongWork          bool   `gorm:"default:false" json:"long_work"`
+	TransportProvided bool   `gorm:"default:false" json:"transport_provided"`
+	TransportType     string `gorm:"size:20" json:"transport_type"`
+	ExtraWageAmount   int64  `gorm:"default:0" json:"extra_wage_amount"`
+
+	Roles []EventTemplateRole `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE" json:"role_requirements"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+type EventTemplateRole struct {
+	ID         uint   `gorm:"primaryKey" json:"id"`
+	TemplateID uint   `gorm:"not null;uniqueIndex:idx_template_role" json:"template_id"`
+	Role       string `gorm:"size:50;not null;uniqueIndex:idx_template_role" json:"role"`
+	Required   uint   `gorm:"not null;default:0" json:"required"`
+}
+
+// NewEvent builds an unsaved event on the given date from the template.
+func (t *EventTemplate) NewEvent(date time.Time) *Event {
+	reqs := make([]EventRoleRequirement, 0, len(t.Roles))
+	for _, r := range t.Roles {
+		reqs = append(reqs, EventRoleRequirement{Role: r.Role, Required: r.Required})
+	}
+
+	return &Event{
+		EventName:         t.EventName,
+		Date:              date,
+		TimeSlot:          t.TimeSlot,
+		ReportingTime:     t.ReportingTime,
+		WorkType:          t.WorkType,
+		LocationLink:      t.LocationLink,
+		RoleRequirements:  reqs,
+		LongWork:          t.LongWork,
+		TransportProvided: t.TransportProvided,
+		TransportType:     t.TransportType,
+		ExtraWageAmount:   t.ExtraWageAmount,
+	}
+}
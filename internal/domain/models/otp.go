@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// OTPCode is a one-time login code sent by SMS. Only the hash is stored.
+type OTPCode struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	Phone      string     `gorm:"size:30;not null;index" json:"phone"`
+	CodeHashed string     `gorm:"not null" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	Attempts   int        `gorm:"not null;default:0" json:"attempts"`
+	ConsumedAt *time.Time `json:"consumed_at"`
+	IP         string     `gorm:"size:64" json:"ip"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
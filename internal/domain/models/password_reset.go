@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// PasswordResetToken is a single-use token from the forgot-password flow.
+// Only the hash is stored.
+type PasswordResetToken struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      uint       `gorm:"not null;index" json:"user_id"`
+	TokenHashed string     `gorm:"not null;unique" json:"-"`
+	ExpiresAt   time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt      *time.Time `json:"used_at"`
+	IP          string     `gorm:"size:64" json:"ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
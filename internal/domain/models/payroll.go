@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+const (
+	PayrollPeriodOpen   = "open"
+	PayrollPeriodClosed = "closed"
+
+	PayoutStatusPending = "pending"
+	PayoutStatusPaid    = "paid"
+
+	// Payment state of a single booking as shown to workers
+	PaymentStatusUnpaid = "unpaid"
+	PaymentStatusPaid   = "paid"
+)
+
+// PayrollPeriod groups completed bookings by event date. Closing it creates
+// one payout per user and locks the bookings against wage changes.
+type PayrollPeriod struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	Name      string     `gorm:"size:100;not null" json:"name"`
+	StartDate time.Time  `gorm:"not null;index" json:"start_date"`
+	EndDate   time.Time  `gorm:"not null;index" json:"end_date"`
+	Status    string     `gorm:"size:20;default:'open';index" json:"status"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	ClosedBy  *uint      `json:"closed_by"`
+
+	Payouts []Payout `gorm:"foreignKey:PeriodID" json:"payouts,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Payout struct {
+	ID           uint       `gorm:"primaryKey" json:"id"`
+	PeriodID     uint       `gorm:"not null;uniqueIndex:idx_payout_period_user" json:"period_id"`
+	UserID       uint       `gorm:"not null;uniqueIndex:idx_payout_period_user;index" json:"user_id"`
+	BookingCount int        `gorm:"default:0" json:"booking_count"`
+	TotalAmount  int64      `gorm:"default:0" json:"total_amount"`
+	Status       string     `gorm:"size:20;default:'pending';index" json:"status"`
+	Reference    string     `gorm:"size:100" json:"reference"`
+	PaidAt       *time.Time `json:"paid_at"`
+	PaidBy       *uint      `json:"paid_by"`
+
+	User  User         `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Items []PayoutItem `gorm:"foreignKey:PayoutID;constraint:OnDelete:CASCADE" json:"items,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PayoutItem struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PayoutID  uint      `gorm:"not null;index" json:"payout_id"`
+	BookingID uint      `gorm:"not null;uniqueIndex" json:"booking_id"`
+	EventID   uint      `gorm:"not null;index" json:"event_id"`
+	Amount    int64     `gorm:"default:0" json:"amount"`
+	Event     Event     `gorm:"foreignKey:EventID" json:"event,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -6,12 +6,25 @@ import (
 	"gorm.io/gorm"
 )
 
+// RefreshToken is one issued refresh token. Every refresh replaces the token
+// with a new row in the same family, so a family is one logged-in session
+// (one device) and FamilyID is the session id carried by the access token.
 type RefreshToken struct {
-	ID           uint           `gorm:"primaryKey" json:"id"`
-	UserID       uint           `gorm:"not null" json:"user_id"`
+	ID           uint           `gorm:"primaryKey" json:"-"`
+	FamilyID     uint           `gorm:"index" json:"session_id"`
+	UserID       uint           `gorm:"not null;index" json:"user_id"`
 	TokenHashed  string         `gorm:"not null;unique" json:"-"`
 	ExpiresAt    time.Time      `gorm:"not null" json:"expires_at"`
+	DeviceName   string         `gorm:"size:100" json:"device_name"`
+	UserAgent    string         `gorm:"size:255" json:"user_agent"`
+	IP           string         `gorm:"size:64" json:"ip"`
+	LastUsedAt   *time.Time     `json:"last_used_at"`
+	// RotatedAt is set once the token has been exchanged; presenting it
+	// again after that is treated as theft
+	RotatedAt    *time.Time     `json:"-"`
+	ReplacedByID *uint          `json:"-"`
+	Current      bool           `gorm:"-" json:"current"`
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
 	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
-}
\ No newline at end of file
+}
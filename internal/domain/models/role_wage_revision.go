@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// RoleWageRevision is one entry in the wage history of a role. The revision
+// with the latest EffectiveFrom on or before an event date sets the base wage
+// for bookings of that event. Future revisions are applied to RoleWage and
+// users by the scheduler once they become effective.
+type RoleWageRevision struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	Role          string     `gorm:"size:50;not null;uniqueIndex:idx_role_effective" json:"role"`
+	Wage          int64      `gorm:"not null" json:"wage"`
+	EffectiveFrom time.Time  `gorm:"not null;uniqueIndex:idx_role_effective" json:"effective_from"`
+	Applied       bool       `gorm:"default:false;index" json:"applied"`
+	AppliedAt     *time.Time `json:"applied_at"`
+	CreatedBy     *uint      `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+const (
+	SecurityEventRefreshReuse = "refresh_token_reuse"
+)
+
+// SecurityEvent records suspicious auth activity for admins to review.
+type SecurityEvent struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;index" json:"user_id"`
+	Kind      string    `gorm:"size:40;not null;index" json:"kind"`
+	SessionID uint      `json:"session_id"`
+	IP        string    `gorm:"size:64" json:"ip"`
+	UserAgent string    `gorm:"size:255" json:"user_agent"`
+	Detail    string    `gorm:"size:255" json:"detail"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -1,8 +1,44 @@
 package models
 
+const (
+	// Hours before reporting time after which workers can no longer cancel.
+	SettingBookingCancelCutoffHours = "booking_cancel_cutoff_hours"
+	// Cancellations within this many hours of reporting time are flagged late.
+	SettingBookingLateCancelHours = "booking_late_cancel_hours"
+
+	// Event lifecycle scheduler
+	SettingSchedulerEnabled         = "scheduler_enabled"
+	SettingSchedulerIntervalMinutes = "scheduler_interval_minutes"
+	// Start upcoming events once their reporting time has passed.
+	SettingAutoStartEvents = "auto_start_events"
+	// Hours after reporting time before an ongoing event counts as overdue.
+	SettingOngoingGraceHours = "ongoing_grace_hours"
+	// Complete overdue events instead of only flagging them.
+	SettingAutoCompleteEvents = "auto_complete_events"
+	// Cancel past-dated upcoming events that have no bookings.
+	SettingAutoCancelEmptyEvents = "auto_cancel_empty_events"
+
+	// Lifetime of the QR check-in token shown by workers.
+	SettingCheckInTokenTTLMinutes = "checkin_token_ttl_minutes"
+	// Allowed distance from the venue for self check-in when the event has none.
+	SettingCheckInRadiusMeters = "checkin_radius_meters"
+
+	// Straight-line distance is multiplied by this percentage to estimate
+	// road distance for travel allowance.
+	SettingTARoadFactorPercent = "ta_road_factor_percent"
+)
+
+// ApprovalThresholdKey is the setting holding the largest TA, bonus or fine
+// (kind) a captain may enter for a booking of the given role without admin
+// approval, e.g. "approval_threshold_bonus_main_boy". Missing or negative
+// means no approval is needed.
+func ApprovalThresholdKey(kind, role string) string {
+	return "approval_threshold_" + kind + "_" + role
+}
+
 type SystemSetting struct {
 	ID          uint   `gorm:"primaryKey" json:"id"`
 	Key         string `gorm:"uniqueIndex;not null" json:"key"` // e.g. "maintenance_mode"
 	Value       string `json:"value"`                           // "true" or "false"
 	Description string `json:"description"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	TARateModePerKm = "per_km"
+	TARateModeBand  = "band"
+
+	// TransportOwn is the policy key for events without provided transport.
+	TransportOwn = "own"
+
+	TARuleManual = "manual"
+)
+
+// StartingPoint is a known place workers leave from. Users are matched to it
+// by their StartingPoint name.
+type StartingPoint struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"size:150;uniqueIndex;not null" json:"name"`
+	Latitude  float64   `gorm:"not null" json:"latitude"`
+	Longitude float64   `gorm:"not null" json:"longitude"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TAPolicy decides the travel allowance for one transport type, either per
+// kilometre or by distance bands, then clamps it to MinAmount and MaxAmount
+// (0 = no cap).
+type TAPolicy struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	TransportType string    `gorm:"size:20;uniqueIndex;not null" json:"transport_type"`
+	Mode          string    `gorm:"size:20;not null" json:"mode"`
+	RatePerKm     int64     `gorm:"default:0" json:"rate_per_km"`
+	MinAmount     int64     `gorm:"default:0" json:"min_amount"`
+	MaxAmount     int64     `gorm:"default:0" json:"max_amount"`
+	RoundTrip     bool      `gorm:"default:false" json:"round_trip"`
+	Bands         []TABand  `gorm:"foreignKey:PolicyID;constraint:OnDelete:CASCADE" json:"bands"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TABand pays Amount for distances up to UpToKm.
+type TABand struct {
+	ID       uint    `gorm:"primaryKey" json:"id"`
+	PolicyID uint    `gorm:"index;not null" json:"-"`
+	UpToKm   float64 `gorm:"not null" json:"up_to_km"`
+	Amount   int64   `gorm:"not null" json:"amount"`
+}
+
+// Amount returns the allowance for a one-way distance. Bands must be ordered
+// by UpToKm; distances past the last band get the last band amount.
+func (p *TAPolicy) Amount(km float64) int64 {
+	if p.RoundTrip {
+		km *= 2
+	}
+
+	var amount int64
+	switch p.Mode {
+	case TARateModePerKm:
+		amount = int64(math.Round(km * float64(p.RatePerKm)))
+	case TARateModeBand:
+		for _, band := range p.Bands {
+			amount = band.Amount
+			if km <= band.UpToKm {
+				break
+			}
+		}
+	}
+
+	if amount < p.MinAmount {
+		amount = p.MinAmount
+	}
+	if p.MaxAmount > 0 && amount > p.MaxAmount {
+		amount = p.MaxAmount
+	}
+	return amount
+}
+
+// Describe explains how Amount arrived at its result, for the booking record.
+func (p *TAPolicy) Describe(km float64, from string) string {
+	trip := "one way"
+	if p.RoundTrip {
+		trip = "round trip"
+	}
+	switch p.Mode {
+	case TARateModePerKm:
+		return fmt.Sprintf("%s %.1f km from %s (%s) at %d/km", p.TransportType, km, from, trip, p.RatePerKm)
+	default:
+		return fmt.Sprintf("%s %.1f km from %s (%s) by distance band", p.TransportType, km, from, trip)
+	}
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"errors"
 	"regexp"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -34,8 +35,21 @@ type User struct {
 	CompletedWork uint           `gorm:"default:0" json:"completed_work"`
 	CurrentWage   int64          `gorm:"default:0" json:"current_wage"`
 	Status        string         `gorm:"size:30;default:'active'" json:"status"`
+	// TokenVersion is bumped when issued access tokens must stop working
+	// (role, permission or status change). Only written by raw updates.
+	TokenVersion  uint           `gorm:"not null;default:0;<-:false" json:"-"`
+	// used for bank bulk-payment files, never serialized; responses only
+	// carry the masked account number
+	BankAccountName   string     `gorm:"size:150" json:"-"`
+	BankAccountNumber string     `gorm:"size:34" json:"-"`
+	BankIFSC          string     `gorm:"size:11" json:"-"`
+	BankName          string     `gorm:"size:100" json:"-"`
+	BankAccountMasked string     `gorm:"-" json:"bank_account_masked,omitempty"`
 	AdminRoleID   *uint          `json:"admin_role_id"`
     AdminRole     *AdminRole     `gorm:"foreignKey:AdminRoleID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL" json:"admin_role"`
+	// WageOverride is only preloaded by user listings; WageOverridden flags it.
+	WageOverride   *WageOverride `gorm:"foreignKey:UserID" json:"wage_override,omitempty"`
+	WageOverridden bool          `gorm:"-" json:"wage_overridden"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
@@ -46,14 +60,23 @@ var (
 	phoneRegex = regexp.MustCompile(`^[0-9]{10}$`)
 )
 
-func ValidateRole(r string) bool {
-	switch r {
-	case RoleAdmin, RoleCaptain, RoleSubCaptain, RoleMainBoy, RoleJuniorBoy:
-		return true
+// StaffRoles are the roles that can be booked onto events.
+// Adding a role here makes it available for event requirements.
+var StaffRoles = []string{RoleCaptain, RoleSubCaptain, RoleMainBoy, RoleJuniorBoy}
+
+func IsStaffRole(r string) bool {
+	for _, role := range StaffRoles {
+		if role == r {
+			return true
+		}
 	}
 	return false
 }
 
+func ValidateRole(r string) bool {
+	return r == RoleAdmin || IsStaffRole(r)
+}
+
 func ValidateStatus(s string) bool {
 	switch s {
 	case StatusActive, StatusBlocked:
@@ -61,6 +84,20 @@ func ValidateStatus(s string) bool {
 	}
 	return false
 }
+func (u *User) AfterFind(tx *gorm.DB) error {
+	u.WageOverridden = u.WageOverride != nil
+	u.BankAccountMasked = MaskAccountNumber(u.BankAccountNumber)
+	return nil
+}
+
+// MaskAccountNumber keeps the last four digits of an account number.
+func MaskAccountNumber(number string) string {
+	if len(number) <= 4 {
+		return number
+	}
+	return strings.Repeat("X", len(number)-4) + number[len(number)-4:]
+}
+
 func (u *User) BeforeCreate(tx *gorm.DB) error {
 	return u.validateFields()
 }
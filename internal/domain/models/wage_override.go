@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	WageOverrideAmount  = "amount"
+	WageOverridePercent = "percent"
+
+	WageChangeSourceOverride = "override"
+)
+
+// WageOverride is an individually negotiated wage for one user. An amount
+// override replaces the computed wage; a percent override adjusts the role
+// wage plus seniority increment by Value percent (negative lowers it).
+// It is in force from StartsAt until ExpiresAt, or until removed when no
+// expiry is set. Replaced, removed and expired overrides are kept so
+// bookings dated inside their window still resolve to them; a user has at
+// most one override that has not expired.
+type WageOverride struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index:idx_wage_overrides_user;not null" json:"user_id"`
+	Type      string     `gorm:"size:20;not null" json:"type"`
+	Value     int64      `gorm:"not null" json:"value"`
+	StartsAt  time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP" json:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Reason    string     `gorm:"size:255;not null" json:"reason"`
+	CreatedBy *uint      `json:"created_by"`
+	// ReleasedAt is set once the scheduler has restored the computed wage
+	ReleasedAt *time.Time `json:"released_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Apply returns the wage after the override is applied to computed.
+func (o *WageOverride) Apply(computed int64) int64 {
+	switch o.Type {
+	case WageOverrideAmount:
+		return o.Value
+	case WageOverridePercent:
+		wage := computed + computed*o.Value/100
+		if wage < 0 {
+			return 0
+		}
+		return wage
+	}
+	return computed
+}
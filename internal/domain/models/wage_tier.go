@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+const (
+	WageChangeSourceRoleWage  = "role_wage"
+	WageChangeSourceSeniority = "seniority"
+	WageChangeSourceTier      = "tier_update"
+	WageChangeSourceRole      = "role_change"
+)
+
+// WageTier adds Increment to the role wage once a user has completed at
+// least MinCompletedWork events. Only the highest tier reached applies.
+type WageTier struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	Role             string    `gorm:"size:50;not null;uniqueIndex:idx_tier_role_min" json:"role"`
+	MinCompletedWork uint      `gorm:"not null;uniqueIndex:idx_tier_role_min" json:"min_completed_work"`
+	Increment        int64     `gorm:"not null" json:"increment"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// WageChangeLog records every change to a user's CurrentWage.
+type WageChangeLog struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	UserID        uint      `gorm:"index;not null" json:"user_id"`
+	Role          string    `gorm:"size:50" json:"role"`
+	OldWage       int64     `json:"old_wage"`
+	NewWage       int64     `json:"new_wage"`
+	CompletedWork uint      `json:"completed_work"`
+	Source        string    `gorm:"size:30;not null" json:"source"`
+	Reason        string    `gorm:"size:255" json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+const (
+	WaitlistStatusWaiting  = "waiting"
+	WaitlistStatusPromoted = "promoted"
+)
+
+type Waitlist struct {
+	ID         uint       `gorm:"primaryKey" json:"id"`
+	EventID    uint       `gorm:"not null;index:idx_waitlist_event_role;uniqueIndex:idx_waitlist_event_user" json:"event_id"`
+	UserID     uint       `gorm:"not null;index;uniqueIndex:idx_waitlist_event_user" json:"user_id"`
+	Role       string     `gorm:"size:50;not null;index:idx_waitlist_event_role" json:"role"`
+	Status     string     `gorm:"size:20;default:'waiting';index" json:"status"`
+	BookingID  *uint      `json:"booking_id"`
+	PromotedAt *time.Time `json:"promoted_at"`
+
+	Event Event `gorm:"foreignKey:EventID" json:"event"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
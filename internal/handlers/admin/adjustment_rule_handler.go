@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdjustmentRuleHandler struct {
+	service *admin.AdjustmentRuleService
+}
+
+func NewAdjustmentRuleHandler(service *admin.AdjustmentRuleService) *AdjustmentRuleHandler {
+	return &AdjustmentRuleHandler{service: service}
+}
+
+// GET /admin/adjustment-rules?active=true
+func (h *AdjustmentRuleHandler) ListRules(c *gin.Context) {
+	rules, err := h.service.ListRules(c.Query("active") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch adjustment rules"})
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// POST /admin/adjustment-rules
+func (h *AdjustmentRuleHandler) CreateRule(c *gin.Context) {
+	var req validations.AdjustmentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule := toAdjustmentRule(&req)
+	if err := h.service.CreateRule(rule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// PUT /admin/adjustment-rules/:id
+func (h *AdjustmentRuleHandler) UpdateRule(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	var req validations.AdjustmentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule := toAdjustmentRule(&req)
+	rule.ID = id
+	if err := h.service.UpdateRule(rule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "adjustment rule updated successfully"})
+}
+
+// DELETE /admin/adjustment-rules/:id
+func (h *AdjustmentRuleHandler) DeleteRule(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	if err := h.service.DeleteRule(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "adjustment rule deleted successfully"})
+}
+
+func toAdjustmentRule(req *validations.AdjustmentRuleRequest) *models.AdjustmentRule {
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	return &models.AdjustmentRule{
+		Name:        req.Name,
+		Kind:        req.Kind,
+		Amount:      req.Amount,
+		Description: req.Description,
+		Active:      active,
+	}
+}
@@ -93,6 +93,35 @@ func (h *AdminBookingHandler) RemoveUserFromEvent(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "user removed from event"})
 }
 
+// ---------------- LIST CANCELLATIONS (ADMIN) ----------------
+// OPTIONAL QUERY: event_id, late=true
+//
+func (h *AdminBookingHandler) ListCancellations(c *gin.Context) {
+	eventID := utils.ParseUintParam(c.Query("event_id"))
+	lateOnly := c.Query("late") == "true"
+
+	data, err := h.service.ListCancellations(eventID, lateOnly)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch cancellations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ---------------- REJECTED SELF CHECK-INS ----------------
+func (h *AdminBookingHandler) ListRejectedCheckIns(c *gin.Context) {
+	eventID := utils.ParseUintParam(c.Query("event_id"))
+
+	data, err := h.service.ListRejectedCheckIns(eventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch rejected check-ins"})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
 // ---------------- UPDATE ATTENDANCE (ADMIN) ----------------
 func (h *AdminBookingHandler) UpdateAttendance(c *gin.Context) {
 	bookingID := utils.ParseUintParam(c.Param("booking_id"))
@@ -120,6 +149,8 @@ func (h *AdminBookingHandler) UpdateAttendance(c *gin.Context) {
 		req.TAAmount,
 		req.BonusAmount,
 		req.FineAmount,
+		req.RuleIDs,
+		c.GetUint("user_id"),
 	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
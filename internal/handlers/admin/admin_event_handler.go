@@ -40,10 +40,10 @@ func (h *AdminEventHandler) CreateEvent(c *gin.Context) {
 		ReportingTime:       req.ReportingTime,
 		WorkType:            req.WorkType,
 		LocationLink:        req.LocationLink,
-		RequiredCaptains:    req.RequiredCaptains,
-		RequiredSubCaptains: req.RequiredSubCaptains,
-		RequiredMainBoys:    req.RequiredMainBoys,
-		RequiredJuniors:     req.RequiredJuniors,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		RadiusMeters:        req.RadiusMeters,
+		RoleRequirements:    toRoleRequirements(req.RoleRequirements),
 		LongWork:            req.LongWork,
 		TransportProvided:   req.TransportProvided,
 		TransportType:       req.TransportType,
@@ -94,6 +94,29 @@ func (h *AdminEventHandler) GetEvent(c *gin.Context) {
 	c.JSON(http.StatusOK, event)
 }
 
+// ---------------- LIFECYCLE LOGS ----------------
+
+// ListLifecycleLogs shows automatic transitions, optionally filtered by
+// ?event_id= and ?action=.
+func (h *AdminEventHandler) ListLifecycleLogs(c *gin.Context) {
+	var eventID uint
+	if v := c.Query("event_id"); v != "" {
+		eventID = utils.ParseUintParam(v)
+		if eventID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+			return
+		}
+	}
+
+	logs, err := h.service.ListLifecycleLogs(eventID, c.Query("action"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch lifecycle logs"})
+		return
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
+
 // ---------------- UPDATE ----------------
 
 func (h *AdminEventHandler) UpdateEvent(c *gin.Context) {
@@ -122,10 +145,10 @@ func (h *AdminEventHandler) UpdateEvent(c *gin.Context) {
 		ReportingTime:       req.ReportingTime,
 		WorkType:            req.WorkType,
 		LocationLink:        req.LocationLink,
-		RequiredCaptains:    req.RequiredCaptains,
-		RequiredSubCaptains: req.RequiredSubCaptains,
-		RequiredMainBoys:    req.RequiredMainBoys,
-		RequiredJuniors:     req.RequiredJuniors,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		RadiusMeters:        req.RadiusMeters,
+		RoleRequirements:    toRoleRequirements(req.RoleRequirements),
 		LongWork:            req.LongWork,
 		TransportProvided:   req.TransportProvided,
 		TransportType:       req.TransportType,
@@ -140,6 +163,41 @@ func (h *AdminEventHandler) UpdateEvent(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "event updated successfully"})
 }
 
+// ---------------- CLONE ----------------
+
+func (h *AdminEventHandler) CloneEvent(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	// body is optional
+	var req validations.CloneEventRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+			return
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	event, err := h.service.CloneEvent(id, req.Date, req.Name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "event cloned successfully",
+		"id":      event.ID,
+	})
+}
+
 // ---------------- START ----------------
 
 func (h *AdminEventHandler) StartEvent(c *gin.Context) {
@@ -207,3 +265,14 @@ func (h *AdminEventHandler) DeleteEvent(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "event deleted"})
 }
+
+func toRoleRequirements(in []validations.RoleRequirementInput) []models.EventRoleRequirement {
+	out := make([]models.EventRoleRequirement, 0, len(in))
+	for _, r := range in {
+		out = append(out, models.EventRoleRequirement{
+			Role:     r.Role,
+			Required: r.Required,
+		})
+	}
+	return out
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
 
 	"github.com/gin-gonic/gin"
 )
@@ -48,3 +50,56 @@ func (h *RoleWageHandler) Update(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "role wage updated successfully"})
 }
+
+// GET /admin/wages/:role/revisions
+func (h *RoleWageHandler) ListRevisions(c *gin.Context) {
+	role := strings.ToLower(c.Param("role"))
+
+	revisions, err := h.service.ListRevisions(role)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+// POST /admin/wages/:role/revisions
+func (h *RoleWageHandler) ScheduleChange(c *gin.Context) {
+	role := strings.ToLower(c.Param("role"))
+
+	var req validations.ScheduleRoleWageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	revision, err := h.service.ScheduleChange(role, req.Wage, req.EffectiveFrom, c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, revision)
+}
+
+// DELETE /admin/wages/revisions/:id
+func (h *RoleWageHandler) CancelRevision(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid revision id"})
+		return
+	}
+
+	if err := h.service.CancelRevision(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "wage revision cancelled"})
+}
@@ -12,6 +12,7 @@ import (
 
 	"event-management-backend/internal/domain/models"
 	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
 	"event-management-backend/internal/validations"
 
 	"github.com/gin-gonic/gin"
@@ -260,6 +261,69 @@ func (h *AdminUserHandler) BlockUser(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "user blocked"})
 }
 
+// DELETE /admin/users/:id/sessions
+func (h *AdminUserHandler) RevokeSessions(c *gin.Context) {
+	id := parseID(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	if err := h.service.RevokeSessions(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "user logged out on all devices"})
+}
+
+// GET /admin/users/security-events?user_id=&kind=
+func (h *AdminUserHandler) ListSecurityEvents(c *gin.Context) {
+	var userID uint
+	if v := c.Query("user_id"); v != "" {
+		userID = parseID(v)
+		if userID == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+			return
+		}
+	}
+
+	events, err := h.service.ListSecurityEvents(userID, c.Query("kind"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch security events"})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// PUT /admin/users/:id/bank-details
+func (h *AdminUserHandler) UpdateBankDetails(c *gin.Context) {
+	id := parseID(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	var req validations.UpdateBankDetailsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.service.UpdateBankDetails(id, req.AccountName, req.AccountNumber, req.IFSC, req.BankName); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "bank details updated"})
+}
+
 func (h *AdminUserHandler) UnblockUser(c *gin.Context) {
 	id := parseID(c.Param("id"))
 	if err := h.service.UnblockUser(id); err != nil {
@@ -287,11 +351,16 @@ func (h *AdminUserHandler) ResetPassword(c *gin.Context) {
 		NewPassword string `json:"new_password"`
 	}
 
-	if err := c.ShouldBindJSON(&body); err != nil || len(body.NewPassword) < 6 {
+	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid password"})
 		return
 	}
 
+	if err := utils.CheckPasswordStrength(body.NewPassword, ""); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	if err := h.service.ResetPassword(id, body.NewPassword); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
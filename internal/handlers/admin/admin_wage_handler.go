@@ -42,6 +42,7 @@ func (h *AdminWageHandler) OverrideWage(c *gin.Context) {
 		req.TAAmount,
 		req.BonusAmount,
 		req.FineAmount,
+		c.GetUint("user_id"),
 	); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -49,3 +50,41 @@ func (h *AdminWageHandler) OverrideWage(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "wage updated successfully"})
 }
+
+// ---------------- APPROVAL QUEUE ----------------
+func (h *AdminWageHandler) ListPendingAdjustments(c *gin.Context) {
+	items, err := h.service.ListPendingAdjustments()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch pending adjustments"})
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+func (h *AdminWageHandler) ApproveAdjustment(c *gin.Context) {
+	h.reviewAdjustment(c, true)
+}
+
+func (h *AdminWageHandler) RejectAdjustment(c *gin.Context) {
+	h.reviewAdjustment(c, false)
+}
+
+func (h *AdminWageHandler) reviewAdjustment(c *gin.Context, approve bool) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid adjustment id"})
+		return
+	}
+
+	if err := h.service.ReviewAdjustment(id, approve, c.GetUint("user_id")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	msg := "adjustment rejected"
+	if approve {
+		msg = "adjustment approved"
+	}
+	c.JSON(http.StatusOK, gin.H{"message": msg})
+}
@@ -0,0 +1,191 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdvanceHandler struct {
+	service *admin.AdvanceService
+}
+
+func NewAdvanceHandler(service *admin.AdvanceService) *AdvanceHandler {
+	return &AdvanceHandler{service: service}
+}
+
+// GET /admin/advances?user_id=&status=
+func (h *AdvanceHandler) ListAdvances(c *gin.Context) {
+	var userID uint
+	if raw := c.Query("user_id"); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+			return
+		}
+		userID = uint(id)
+	}
+
+	status := c.Query("status")
+	switch status {
+	case "", models.AdvanceStatusActive, models.AdvanceStatusSettled, models.AdvanceStatusWrittenOff:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status"})
+		return
+	}
+
+	advances, err := h.service.ListAdvances(userID, status)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch advances"})
+		return
+	}
+
+	c.JSON(http.StatusOK, advances)
+}
+
+// GET /admin/advances/:id
+func (h *AdvanceHandler) GetAdvance(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid advance id"})
+		return
+	}
+
+	advance, err := h.service.GetAdvance(id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// POST /admin/users/:id/advances
+func (h *AdvanceHandler) IssueAdvance(c *gin.Context) {
+	userID := utils.ParseUintParam(c.Param("id"))
+	if userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	var req validations.IssueAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var issuedAt time.Time
+	if req.IssuedAt != nil {
+		issuedAt = *req.IssuedAt
+	}
+
+	advance, err := h.service.IssueAdvance(userID, &models.Advance{
+		Amount:            req.Amount,
+		PerEventCap:       req.PerEventCap,
+		InstallmentAmount: req.InstallmentAmount,
+		IssuedAt:          issuedAt,
+		Note:              req.Note,
+	}, c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, advance)
+}
+
+// PUT /admin/advances/:id
+func (h *AdvanceHandler) UpdateSchedule(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid advance id"})
+		return
+	}
+
+	var req validations.UpdateAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	advance, err := h.service.UpdateSchedule(id, req.PerEventCap, req.InstallmentAmount, req.Note)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// POST /admin/advances/:id/repayments
+func (h *AdvanceHandler) RecordRepayment(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid advance id"})
+		return
+	}
+
+	var req validations.AdvanceRepaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	advance, err := h.service.RecordRepayment(id, req.Amount, req.Note, c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
+
+// PUT /admin/advances/:id/write-off
+func (h *AdvanceHandler) WriteOff(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid advance id"})
+		return
+	}
+
+	var req validations.WriteOffAdvanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	advance, err := h.service.WriteOff(id, req.Note)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, advance)
+}
@@ -0,0 +1,183 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type EventSeriesHandler struct {
+	service *admin.EventSeriesService
+}
+
+func NewEventSeriesHandler(service *admin.EventSeriesService) *EventSeriesHandler {
+	return &EventSeriesHandler{service: service}
+}
+
+// ---------------- CREATE ----------------
+
+func (h *EventSeriesHandler) CreateSeries(c *gin.Context) {
+	var req validations.CreateEventSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	series := &models.EventSeries{
+		Name:      req.Event.Name,
+		Frequency: req.Frequency,
+		Interval:  req.Interval,
+		Weekdays:  strings.Join(req.Weekdays, ","),
+		StartDate: req.StartDate,
+		UntilDate: req.UntilDate,
+		Count:     req.Count,
+	}
+
+	template := &models.Event{
+		EventName:         req.Event.Name,
+		TimeSlot:          req.Event.TimeSlot,
+		ReportingTime:     req.Event.ReportingTime,
+		WorkType:          req.Event.WorkType,
+		LocationLink:      req.Event.LocationLink,
+		Latitude:          req.Event.Latitude,
+		Longitude:         req.Event.Longitude,
+		RadiusMeters:      req.Event.RadiusMeters,
+		RoleRequirements:  toRoleRequirements(req.Event.RoleRequirements),
+		LongWork:          req.Event.LongWork,
+		TransportProvided: req.Event.TransportProvided,
+		TransportType:     req.Event.TransportType,
+		ExtraWageAmount:   req.Event.ExtraWageAmount,
+	}
+
+	if err := h.service.CreateSeries(series, template); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "event series created successfully",
+		"id":      series.ID,
+	})
+}
+
+// ---------------- LIST ----------------
+
+func (h *EventSeriesHandler) ListSeries(c *gin.Context) {
+	series, err := h.service.ListSeries(c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch event series"})
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// ---------------- GET ----------------
+
+func (h *EventSeriesHandler) GetSeries(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid series id"})
+		return
+	}
+
+	series, err := h.service.GetSeries(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "series not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch event series"})
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// ---------------- UPDATE OCCURRENCES ----------------
+
+// UpdateOccurrences edits one event of a series. The scope query parameter
+// picks "this" (default), "following" or "all".
+func (h *EventSeriesHandler) UpdateOccurrences(c *gin.Context) {
+	seriesID := utils.ParseUintParam(c.Param("id"))
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if seriesID == 0 || eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid series or event id"})
+		return
+	}
+
+	scope := c.DefaultQuery("scope", models.SeriesScopeThis)
+
+	var req validations.UpdateEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := &models.Event{
+		EventName:         req.Name,
+		Date:              req.Date,
+		TimeSlot:          req.TimeSlot,
+		ReportingTime:     req.ReportingTime,
+		WorkType:          req.WorkType,
+		LocationLink:      req.LocationLink,
+		Latitude:          req.Latitude,
+		Longitude:         req.Longitude,
+		RadiusMeters:      req.RadiusMeters,
+		RoleRequirements:  toRoleRequirements(req.RoleRequirements),
+		LongWork:          req.LongWork,
+		TransportProvided: req.TransportProvided,
+		TransportType:     req.TransportType,
+		ExtraWageAmount:   req.ExtraWageAmount,
+	}
+
+	updated, err := h.service.UpdateOccurrences(seriesID, eventID, scope, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "events updated successfully",
+		"updated": updated,
+	})
+}
+
+// ---------------- CANCEL ----------------
+
+func (h *EventSeriesHandler) CancelSeries(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid series id"})
+		return
+	}
+
+	cancelled, err := h.service.CancelSeries(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "event series cancelled",
+		"cancelled": cancelled,
+	})
+}
@@ -0,0 +1,195 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type EventTemplateHandler struct {
+	service *admin.EventTemplateService
+}
+
+func NewEventTemplateHandler(service *admin.EventTemplateService) *EventTemplateHandler {
+	return &EventTemplateHandler{service: service}
+}
+
+// ---------------- CREATE ----------------
+
+func (h *EventTemplateHandler) CreateTemplate(c *gin.Context) {
+	var req validations.EventTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template := toEventTemplate(&req)
+	if err := h.service.CreateTemplate(template); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "event template created successfully",
+		"id":      template.ID,
+	})
+}
+
+// ---------------- LIST ----------------
+
+func (h *EventTemplateHandler) ListTemplates(c *gin.Context) {
+	templates, err := h.service.ListTemplates()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch event templates"})
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+// ---------------- GET ----------------
+
+func (h *EventTemplateHandler) GetTemplate(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid template id"})
+		return
+	}
+
+	template, err := h.service.GetTemplate(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch event template"})
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// ---------------- UPDATE ----------------
+
+func (h *EventTemplateHandler) UpdateTemplate(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid template id"})
+		return
+	}
+
+	var req validations.EventTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template := toEventTemplate(&req)
+	template.ID = id
+	if err := h.service.UpdateTemplate(template); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "event template updated successfully"})
+}
+
+// ---------------- DELETE ----------------
+
+func (h *EventTemplateHandler) DeleteTemplate(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid template id"})
+		return
+	}
+
+	if err := h.service.DeleteTemplate(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "event template deleted"})
+}
+
+// ---------------- CREATE EVENT FROM TEMPLATE ----------------
+
+func (h *EventTemplateHandler) CreateEventFromTemplate(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid template id"})
+		return
+	}
+
+	var req validations.EventFromTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	overrides := &models.Event{
+		EventName:        req.Name,
+		Date:             req.Date,
+		TimeSlot:         req.TimeSlot,
+		ReportingTime:    req.ReportingTime,
+		WorkType:         req.WorkType,
+		LocationLink:     req.LocationLink,
+		RoleRequirements: toRoleRequirements(req.RoleRequirements),
+	}
+
+	event, err := h.service.CreateEventFromTemplate(id, overrides, req.ExtraWageAmount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "event created successfully",
+		"id":      event.ID,
+	})
+}
+
+func toEventTemplate(req *validations.EventTemplateRequest) *models.EventTemplate {
+	roles := make([]models.EventTemplateRole, 0, len(req.RoleRequirements))
+	for _, r := range req.RoleRequirements {
+		roles = append(roles, models.EventTemplateRole{
+			Role:     r.Role,
+			Required: r.Required,
+		})
+	}
+
+	return &models.EventTemplate{
+		Name:              req.Name,
+		EventName:         req.EventName,
+		TimeSlot:          req.TimeSlot,
+		ReportingTime:     req.ReportingTime,
+		WorkType:          req.WorkType,
+		LocationLink:      req.LocationLink,
+		Roles:             roles,
+		LongWork:          req.LongWork,
+		TransportProvided: req.TransportProvided,
+		TransportType:     req.TransportType,
+		ExtraWageAmount:   req.ExtraWageAmount,
+	}
+}
@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"event-management-backend/internal/services/export"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	service *export.ExportService
+}
+
+func NewExportHandler(service *export.ExportService) *ExportHandler {
+	return &ExportHandler{service: service}
+}
+
+// ---------------- EVENT WAGE SHEET ----------------
+// GET /admin/reports/events/:event_id/wages/export?format=csv|xlsx
+func (h *ExportHandler) ExportEventWages(c *gin.Context) {
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	format := c.DefaultQuery("format", export.FormatCSV)
+
+	event, err := h.service.FindEvent(eventID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	sheet, ok := startSheet(c, format, fmt.Sprintf("wages_event_%d", eventID), event.EventName)
+	if !ok {
+		return
+	}
+
+	// headers are already sent, so a failure can only be logged
+	if err := h.service.WriteEventWages(sheet, eventID); err != nil {
+		log.Printf("wage export for event %d failed: %v", eventID, err)
+	}
+}
+
+// ---------------- DATE RANGE WAGE SHEET ----------------
+// GET /admin/reports/wages/export?from=YYYY-MM-DD&to=YYYY-MM-DD&format=csv|xlsx
+func (h *ExportHandler) ExportRangeWages(c *gin.Context) {
+	from, err := time.Parse("2006-01-02", c.Query("from"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from must be YYYY-MM-DD"})
+		return
+	}
+	to, err := time.Parse("2006-01-02", c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to must be YYYY-MM-DD"})
+		return
+	}
+	if to.Before(from) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "to cannot be before from"})
+		return
+	}
+
+	format := c.DefaultQuery("format", export.FormatCSV)
+	name := fmt.Sprintf("wages_%s_%s", from.Format("20060102"), to.Format("20060102"))
+
+	sheet, ok := startSheet(c, format, name, "Wages")
+	if !ok {
+		return
+	}
+
+	if err := h.service.WriteRangeWages(sheet, from, to); err != nil {
+		log.Printf("wage export %s failed: %v", name, err)
+	}
+}
+
+// ---------------- BANK BULK-PAYMENT FILE ----------------
+// GET /admin/payroll/periods/:id/bank-file?format=neft_csv&value_date=YYYY-MM-DD
+func (h *ExportHandler) ExportBankFile(c *gin.Context) {
+	periodID := utils.ParseUintParam(c.Param("id"))
+	if periodID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	format, err := export.BankFormatByName(c.DefaultQuery("format", "neft_csv"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	valueDate := time.Now()
+	if raw := c.Query("value_date"); raw != "" {
+		if valueDate, err = time.Parse("2006-01-02", raw); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "value_date must be YYYY-MM-DD"})
+			return
+		}
+	}
+
+	if err := h.service.CheckBankFile(periodID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="bank_period_%d.%s"`, periodID, format.Extension()))
+	c.Status(http.StatusOK)
+
+	if err := h.service.WriteBankFile(c.Writer, format, periodID, valueDate); err != nil {
+		log.Printf("bank file for period %d failed: %v", periodID, err)
+	}
+}
+
+// startSheet sends the download headers and opens the sheet writer.
+func startSheet(c *gin.Context, format, filename, sheetName string) (export.Sheet, bool) {
+	if format != export.FormatCSV && format != export.FormatXLSX {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or xlsx"})
+		return nil, false
+	}
+
+	c.Header("Content-Type", export.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, filename, format))
+	c.Status(http.StatusOK)
+
+	sheet, err := export.NewSheet(format, c.Writer, sheetName)
+	if err != nil {
+		log.Printf("export %s failed: %v", filename, err)
+		return nil, false
+	}
+	return sheet, true
+}
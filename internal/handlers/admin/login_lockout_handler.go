@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/services/loginlimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoginLockoutHandler struct {
+	limiter *loginlimit.Limiter
+}
+
+func NewLoginLockoutHandler(limiter *loginlimit.Limiter) *LoginLockoutHandler {
+	return &LoginLockoutHandler{limiter: limiter}
+}
+
+// ---------------- LIST ----------------
+// GET /admin/login-lockouts
+func (h *LoginLockoutHandler) ListLockouts(c *gin.Context) {
+	entries, err := h.limiter.Entries()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch lockouts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// ---------------- CLEAR ----------------
+// DELETE /admin/login-lockouts/:kind/:value, kind is phone or ip
+func (h *LoginLockoutHandler) ClearLockout(c *gin.Context) {
+	value := c.Param("value")
+	if value == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "value is required"})
+		return
+	}
+
+	if err := h.limiter.Clear(c.Param("kind"), value); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "lockout cleared"})
+}
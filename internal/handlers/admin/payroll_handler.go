@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type PayrollHandler struct {
+	service *admin.PayrollService
+}
+
+func NewPayrollHandler(service *admin.PayrollService) *PayrollHandler {
+	return &PayrollHandler{service: service}
+}
+
+// ---------------- CREATE PERIOD ----------------
+
+func (h *PayrollHandler) CreatePeriod(c *gin.Context) {
+	var req validations.CreatePayrollPeriodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	period := &models.PayrollPeriod{
+		Name:      req.Name,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+
+	if err := h.service.CreatePeriod(period); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "payroll period created successfully",
+		"id":      period.ID,
+	})
+}
+
+// ---------------- LIST PERIODS ----------------
+
+func (h *PayrollHandler) ListPeriods(c *gin.Context) {
+	periods, err := h.service.ListPeriods(c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch payroll periods"})
+		return
+	}
+
+	c.JSON(http.StatusOK, periods)
+}
+
+// ---------------- GET PERIOD ----------------
+
+func (h *PayrollHandler) GetPeriod(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	period, err := h.service.GetPeriod(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "payroll period not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch payroll period"})
+		return
+	}
+
+	c.JSON(http.StatusOK, period)
+}
+
+// ---------------- CLOSE PERIOD ----------------
+
+func (h *PayrollHandler) ClosePeriod(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	period, err := h.service.ClosePeriod(id, c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "payroll period closed",
+		"period":  period,
+	})
+}
+
+// ---------------- LIST PAYOUTS ----------------
+
+func (h *PayrollHandler) ListPayouts(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	payouts, err := h.service.ListPayouts(id, c.Query("status"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch payouts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, payouts)
+}
+
+// ---------------- GET PAYOUT ----------------
+
+func (h *PayrollHandler) GetPayout(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payout id"})
+		return
+	}
+
+	payout, err := h.service.GetPayout(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "payout not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch payout"})
+		return
+	}
+
+	c.JSON(http.StatusOK, payout)
+}
+
+// ---------------- MARK PAID ----------------
+
+func (h *PayrollHandler) MarkPaid(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payout id"})
+		return
+	}
+
+	var req validations.MarkPayoutPaidRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.service.MarkPaid(id, c.GetUint("user_id"), req.Reference); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "payout marked as paid"})
+}
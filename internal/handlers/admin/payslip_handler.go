@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"event-management-backend/internal/services/payslip"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminPayslipHandler struct {
+	service *payslip.PayslipService
+}
+
+func NewAdminPayslipHandler(service *payslip.PayslipService) *AdminPayslipHandler {
+	return &AdminPayslipHandler{service: service}
+}
+
+// ---------------- BULK DOWNLOAD ----------------
+// Streams a zip with one payslip per payout of a closed period.
+func (h *AdminPayslipHandler) DownloadPeriodPayslips(c *gin.Context) {
+	periodID := utils.ParseUintParam(c.Param("id"))
+	if periodID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	if err := h.service.CheckPeriodClosed(periodID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="payslips_period_%d.zip"`, periodID))
+	c.Status(http.StatusOK)
+
+	// headers are already sent, so a failure can only be logged
+	if err := h.service.WritePeriodZip(c.Writer, periodID); err != nil {
+		log.Printf("payslip zip for period %d failed: %v", periodID, err)
+	}
+}
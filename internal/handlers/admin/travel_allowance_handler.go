@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TravelAllowanceHandler struct {
+	service *admin.TravelAllowanceService
+}
+
+func NewTravelAllowanceHandler(service *admin.TravelAllowanceService) *TravelAllowanceHandler {
+	return &TravelAllowanceHandler{service: service}
+}
+
+// ---------------- STARTING POINTS ----------------
+
+// GET /admin/ta/starting-points
+func (h *TravelAllowanceHandler) ListStartingPoints(c *gin.Context) {
+	points, err := h.service.ListStartingPoints()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch starting points"})
+		return
+	}
+
+	c.JSON(http.StatusOK, points)
+}
+
+// POST /admin/ta/starting-points
+func (h *TravelAllowanceHandler) CreateStartingPoint(c *gin.Context) {
+	var req validations.StartingPointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	point := &models.StartingPoint{
+		Name:      req.Name,
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+	}
+	if err := h.service.CreateStartingPoint(point); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, point)
+}
+
+// PUT /admin/ta/starting-points/:id
+func (h *TravelAllowanceHandler) UpdateStartingPoint(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid starting point id"})
+		return
+	}
+
+	var req validations.StartingPointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	point := &models.StartingPoint{
+		ID:        id,
+		Name:      req.Name,
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+	}
+	if err := h.service.UpdateStartingPoint(point); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "starting point updated successfully"})
+}
+
+// DELETE /admin/ta/starting-points/:id
+func (h *TravelAllowanceHandler) DeleteStartingPoint(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid starting point id"})
+		return
+	}
+
+	if err := h.service.DeleteStartingPoint(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "starting point deleted successfully"})
+}
+
+// ---------------- POLICIES ----------------
+
+// GET /admin/ta/policies
+func (h *TravelAllowanceHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.service.ListPolicies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch TA policies"})
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// POST /admin/ta/policies
+func (h *TravelAllowanceHandler) CreatePolicy(c *gin.Context) {
+	var req validations.TAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy := toTAPolicy(&req)
+	if err := h.service.CreatePolicy(policy); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, policy)
+}
+
+// PUT /admin/ta/policies/:id
+func (h *TravelAllowanceHandler) UpdatePolicy(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	var req validations.TAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy := toTAPolicy(&req)
+	policy.ID = id
+	if err := h.service.UpdatePolicy(policy); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "TA policy updated successfully"})
+}
+
+// DELETE /admin/ta/policies/:id
+func (h *TravelAllowanceHandler) DeletePolicy(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	if err := h.service.DeletePolicy(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "TA policy deleted successfully"})
+}
+
+// ---------------- PROPOSALS ----------------
+
+// GET /admin/events/bookings/:event_id/ta-proposals
+func (h *TravelAllowanceHandler) ListProposals(c *gin.Context) {
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	proposals, err := h.service.ListProposals(eventID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, proposals)
+}
+
+func toTAPolicy(req *validations.TAPolicyRequest) *models.TAPolicy {
+	bands := make([]models.TABand, 0, len(req.Bands))
+	for _, b := range req.Bands {
+		bands = append(bands, models.TABand{UpToKm: b.UpToKm, Amount: b.Amount})
+	}
+
+	return &models.TAPolicy{
+		TransportType: req.TransportType,
+		Mode:          req.Mode,
+		RatePerKm:     req.RatePerKm,
+		MinAmount:     req.MinAmount,
+		MaxAmount:     req.MaxAmount,
+		RoundTrip:     req.RoundTrip,
+		Bands:         bands,
+	}
+}
@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WageOverrideHandler struct {
+	service *admin.WageOverrideService
+}
+
+func NewWageOverrideHandler(service *admin.WageOverrideService) *WageOverrideHandler {
+	return &WageOverrideHandler{service: service}
+}
+
+// GET /admin/users/:id/wage-override
+func (h *WageOverrideHandler) GetOverride(c *gin.Context) {
+	userID := utils.ParseUintParam(c.Param("id"))
+	if userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	override, err := h.service.GetOverride(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, override)
+}
+
+// PUT /admin/users/:id/wage-override
+func (h *WageOverrideHandler) SetOverride(c *gin.Context) {
+	userID := utils.ParseUintParam(c.Param("id"))
+	if userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	var req validations.WageOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	override, err := h.service.SetOverride(userID, &models.WageOverride{
+		Type:      req.Type,
+		Value:     req.Value,
+		ExpiresAt: req.ExpiresAt,
+		Reason:    req.Reason,
+	}, c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, override)
+}
+
+// DELETE /admin/users/:id/wage-override
+func (h *WageOverrideHandler) RemoveOverride(c *gin.Context) {
+	userID := utils.ParseUintParam(c.Param("id"))
+	if userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	if err := h.service.RemoveOverride(userID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "wage override removed"})
+}
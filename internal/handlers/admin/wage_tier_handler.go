@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"net/http"
+	"strings"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WageTierHandler struct {
+	service *admin.WageTierService
+}
+
+func NewWageTierHandler(service *admin.WageTierService) *WageTierHandler {
+	return &WageTierHandler{service: service}
+}
+
+// GET /admin/wages/tiers?role=
+func (h *WageTierHandler) ListTiers(c *gin.Context) {
+	role := strings.ToLower(c.Query("role"))
+
+	tiers, err := h.service.ListTiers(role)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tiers)
+}
+
+// POST /admin/wages/tiers
+func (h *WageTierHandler) CreateTier(c *gin.Context) {
+	var req validations.WageTierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tier := &models.WageTier{
+		Role:             req.Role,
+		MinCompletedWork: req.MinCompletedWork,
+		Increment:        req.Increment,
+	}
+	if err := h.service.CreateTier(tier); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tier)
+}
+
+// PUT /admin/wages/tiers/:id
+func (h *WageTierHandler) UpdateTier(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tier id"})
+		return
+	}
+
+	var req validations.WageTierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tier := &models.WageTier{
+		ID:               id,
+		Role:             req.Role,
+		MinCompletedWork: req.MinCompletedWork,
+		Increment:        req.Increment,
+	}
+	if err := h.service.UpdateTier(tier); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "wage tier updated successfully"})
+}
+
+// DELETE /admin/wages/tiers/:id
+func (h *WageTierHandler) DeleteTier(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tier id"})
+		return
+	}
+
+	if err := h.service.DeleteTier(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "wage tier deleted successfully"})
+}
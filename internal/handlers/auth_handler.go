@@ -5,9 +5,14 @@ import (
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
 	"event-management-backend/internal/services/auth"
+	"event-management-backend/internal/services/loginlimit"
 	"event-management-backend/internal/utils"
 	"event-management-backend/internal/validations"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,11 +20,19 @@ import (
 type AuthHandler struct {
 	UserRepo    interfaces.UserRepository
 	RefreshRepo interfaces.RefreshTokenRepository
+	WageLogRepo interfaces.WageChangeLogRepository
 	JWTService  *auth.JWTService
+	Sessions    *auth.SessionService
+	OTP         *auth.OTPService
+	Passwords   *auth.PasswordService
+	Limiter     *loginlimit.Limiter
 }
 
-func NewAuthHandler(u interfaces.UserRepository, r interfaces.RefreshTokenRepository, j *auth.JWTService) *AuthHandler {
-	return &AuthHandler{UserRepo: u, RefreshRepo: r, JWTService: j}
+// profileWageHistoryLimit caps the wage changes returned with the profile.
+const profileWageHistoryLimit = 20
+
+func NewAuthHandler(u interfaces.UserRepository, r interfaces.RefreshTokenRepository, w interfaces.WageChangeLogRepository, j *auth.JWTService, sessions *auth.SessionService, otp *auth.OTPService, passwords *auth.PasswordService, limiter *loginlimit.Limiter) *AuthHandler {
+	return &AuthHandler{UserRepo: u, RefreshRepo: r, WageLogRepo: w, JWTService: j, Sessions: sessions, OTP: otp, Passwords: passwords, Limiter: limiter}
 }
 func (h *AuthHandler) Login(c *gin.Context) {
     var req validations.LoginRequest
@@ -33,25 +46,43 @@ func (h *AuthHandler) Login(c *gin.Context) {
         return
     }
 
-    // 1. Fetch user by phone
+    // 1. Throttle per phone and per IP; the attempt counts as failed
+    // until the password checks out
+    reservation, wait := h.Limiter.Reserve(req.Phone, c.ClientIP())
+    if wait > 0 {
+        c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+        c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many failed attempts, try again later"})
+        return
+    }
+
+    // 2. Fetch user by phone
     user, err := h.UserRepo.FindByPhone(req.Phone)
     if err != nil {
         c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
         return
     }
 
-    // 2. Security Check: Account Status
+    // 3. Security Check: Account Status
     if user.Status == models.StatusBlocked {
+        reservation.Cancel()
         c.JSON(http.StatusForbidden, gin.H{"error": "your account is blocked. please contact admin."})
         return
     }
 
-    // 3. Verify Password
+    // 4. Verify Password
     if !utils.CheckPasswordHash(req.Password, user.Password) {
         c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
         return
     }
-    
+    reservation.Succeed()
+
+    // 5. Issue tokens
+    h.startSession(c, user, req.DeviceName)
+}
+
+// startSession runs the steps shared by every login method once the user
+// has proved who they are.
+func (h *AuthHandler) startSession(c *gin.Context, user *models.User, device string) {
     var maintSetting models.SystemSetting
 		if err := config.DB.
 			Where("key = ?", "maintenance_mode").
@@ -67,45 +98,30 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		}
 
 
-    // 4. Handle RBAC: Collect permissions only if the user is an admin
-    permissions := []string{}
-    if user.Role == models.RoleAdmin && user.AdminRole != nil {
-        for _, p := range user.AdminRole.Permissions {
-            permissions = append(permissions, p.Slug)
-        }
-    }
+    // 1. Handle RBAC: Collect permissions only if the user is an admin
+    permissions := userPermissions(user)
 
-    // 5. Generate Tokens
-    // Passing permissions to GenerateAccessToken ensures they are embedded in the JWT claims
-    accessToken, err := h.JWTService.GenerateAccessToken(user.ID, user.Role, permissions)
-    if err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate access token"})
-        return
-    }
-    
-    rawRefresh, hashedRefresh, expiresAt, err := h.JWTService.GenerateRefreshToken()
+    // 2. Session Persistence
+    // Each login is its own session, other devices stay logged in
+    rawRefresh, session, err := h.Sessions.Start(user.ID, requestDevice(c, device))
     if err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate refresh token"})
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save session"})
         return
     }
 
-    // 6. Session Persistence
-    _ = h.RefreshRepo.DeleteByUserID(user.ID)
-    err = h.RefreshRepo.Save(&models.RefreshToken{
-        UserID:      user.ID,
-        TokenHashed: hashedRefresh,
-        ExpiresAt:   expiresAt,
-    })
+    // 3. Generate Access Token
+    // Passing permissions to GenerateAccessToken ensures they are embedded in the JWT claims
+    accessToken, err := h.JWTService.GenerateAccessToken(user.ID, user.Role, permissions, session.FamilyID, user.TokenVersion)
     if err != nil {
-        c.JSON(http.StatusInternalServerError, gin.H{"error": "could not save session"})
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate access token"})
         return
     }
 
-    // 7. Set HTTP-Only Cookies for Security
+    // 4. Set HTTP-Only Cookies for Security
     utils.SetAccessToken(c, accessToken)
     utils.SetRefreshToken(c, rawRefresh)
 
-    // 8. Return JSON response for the Frontend
+    // 5. Return JSON response for the Frontend
     c.JSON(http.StatusOK, gin.H{
         "user": gin.H{
             "id":          user.ID,
@@ -116,6 +132,66 @@ func (h *AuthHandler) Login(c *gin.Context) {
     })
 }
 
+// RequestOTP texts a one-time login code to the phone.
+func (h *AuthHandler) RequestOTP(c *gin.Context) {
+	var req validations.OTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "phone is required"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.OTP.Request(req.Phone, c.ClientIP()); err != nil {
+		if errors.Is(err, auth.ErrOTPTooSoon) || errors.Is(err, auth.ErrOTPHourlyLimit) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not send otp"})
+		return
+	}
+
+	// same answer whether or not the phone is registered
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "if the number is registered, an otp has been sent",
+		"expires_in": int(auth.OTPTTL.Seconds()),
+	})
+}
+
+// VerifyOTP logs the user in with the code from RequestOTP.
+func (h *AuthHandler) VerifyOTP(c *gin.Context) {
+	var req validations.OTPVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "phone and otp are required"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.OTP.Verify(req.Phone, req.OTP)
+	if err != nil {
+		if errors.Is(err, auth.ErrOTPInvalid) || errors.Is(err, auth.ErrOTPAttempts) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not verify otp"})
+		return
+	}
+
+	if user.Status == models.StatusBlocked {
+		c.JSON(http.StatusForbidden, gin.H{"error": "your account is blocked. please contact admin."})
+		return
+	}
+
+	h.startSession(c, user, req.DeviceName)
+}
+
 // func (h *AuthHandler) WorkerLogin(c *gin.Context) {
 // 	var req validations.LoginRequest
 // 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -246,15 +322,267 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "token required"})
 		return
 	}
-	userID, exists := c.Get("user_id")
-	if exists {
-		_ = h.RefreshRepo.DeleteByUserID(userID.(uint))
+	// only this device is logged out
+	if sessionID := h.currentSessionID(c); sessionID != 0 {
+		_ = h.RefreshRepo.DeleteFamily(c.GetUint("user_id"), sessionID)
 	}
 	utils.ClearAccessToken(c)
 	utils.ClearRefreshToken(c)
 	c.JSON(http.StatusOK, gin.H{"message": "logout successful"})
 }
 
+// Refresh exchanges the refresh cookie for a new access token and a new
+// refresh token. The old refresh token stops working; presenting it again
+// logs that session out.
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	raw, _ := c.Cookie("refresh_token")
+
+	rawRefresh, session, err := h.Sessions.Rotate(raw, requestDevice(c, ""))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrRefreshRaced):
+			// another request already rotated it, keep the new cookie
+			c.JSON(http.StatusConflict, gin.H{"error": "session was just refreshed, retry the request"})
+		case errors.Is(err, auth.ErrRefreshInvalid), errors.Is(err, auth.ErrRefreshExpired), errors.Is(err, auth.ErrRefreshReused):
+			utils.ClearAccessToken(c)
+			utils.ClearRefreshToken(c)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not refresh session"})
+		}
+		return
+	}
+
+	// role and permissions are read again so admin changes apply on refresh
+	user, err := h.UserRepo.FindByID(session.UserID)
+	if err != nil || user.Status == models.StatusBlocked {
+		_ = h.RefreshRepo.DeleteFamily(session.UserID, session.FamilyID)
+		utils.ClearAccessToken(c)
+		utils.ClearRefreshToken(c)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "login required"})
+		return
+	}
+
+	permissions := userPermissions(user)
+	accessToken, err := h.JWTService.GenerateAccessToken(user.ID, user.Role, permissions, session.FamilyID, user.TokenVersion)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not generate access token"})
+		return
+	}
+
+	utils.SetAccessToken(c, accessToken)
+	utils.SetRefreshToken(c, rawRefresh)
+
+	c.JSON(http.StatusOK, gin.H{
+		"user": gin.H{
+			"id":          user.ID,
+			"name":        user.Name,
+			"role":        user.Role,
+			"permissions": permissions,
+		},
+	})
+}
+
+// ChangePassword lets a logged-in user set a new password. Other devices are
+// logged out; this one gets a fresh access token.
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req validations.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "current and new password are required"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := c.GetUint("user_id")
+	sessionID := h.currentSessionID(c)
+	if err := h.Passwords.Change(userID, sessionID, req.CurrentPassword, req.NewPassword); err != nil {
+		if errors.Is(err, auth.ErrWrongPassword) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// the change bumped the token version, so reissue for this session
+	if user, err := h.UserRepo.FindByID(userID); err == nil {
+		if token, err := h.JWTService.GenerateAccessToken(user.ID, user.Role, userPermissions(user), sessionID, user.TokenVersion); err == nil {
+			utils.SetAccessToken(c, token)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "password changed, other devices logged out"})
+}
+
+// ForgotPassword sends a single-use reset token to the user's phone.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req validations.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "phone is required"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.Passwords.Forgot(req.Phone, c.ClientIP()); err != nil {
+		if errors.Is(err, auth.ErrResetLimitExceeded) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not send reset token"})
+		return
+	}
+
+	// same answer whether or not the phone is registered
+	c.JSON(http.StatusOK, gin.H{"message": "if the number is registered, a reset token has been sent"})
+}
+
+// ResetPassword sets a new password with the token from ForgotPassword and
+// logs the user out everywhere.
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req validations.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token and new password are required"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.Passwords.Reset(req.Token, req.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	utils.ClearAccessToken(c)
+	utils.ClearRefreshToken(c)
+	c.JSON(http.StatusOK, gin.H{"message": "password reset, please log in"})
+}
+
+// LogoutAll ends every session of the user, including this one.
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	if err := h.RefreshRepo.DeleteByUserID(c.GetUint("user_id")); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not end sessions"})
+		return
+	}
+	utils.ClearAccessToken(c)
+	utils.ClearRefreshToken(c)
+	c.JSON(http.StatusOK, gin.H{"message": "logged out on all devices"})
+}
+
+// ListSessions returns the devices the user is logged in on.
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	sessions, err := h.RefreshRepo.ListActiveByUserID(c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "could not fetch sessions"})
+		return
+	}
+
+	current := h.currentSessionID(c)
+	for i := range sessions {
+		sessions[i].Current = sessions[i].FamilyID == current
+	}
+	c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession logs one device out. Its access token stays valid until
+// it expires, at most AccessTTL.
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	id := utils.ParseUintParam(c.Param("id"))
+	if id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session id"})
+		return
+	}
+
+	if err := h.RefreshRepo.DeleteFamily(c.GetUint("user_id"), id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+		return
+	}
+
+	if id == h.currentSessionID(c) {
+		utils.ClearAccessToken(c)
+		utils.ClearRefreshToken(c)
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "session revoked"})
+}
+
+// currentSessionID comes from the access token, or from the refresh cookie
+// for tokens issued before sessions were tracked.
+func (h *AuthHandler) currentSessionID(c *gin.Context) uint {
+	if id := c.GetUint("session_id"); id != 0 {
+		return id
+	}
+	raw, err := c.Cookie("refresh_token")
+	if err != nil || raw == "" {
+		return 0
+	}
+	rt, err := h.RefreshRepo.FindByHashedToken(utils.HashToken(raw))
+	if err != nil || rt.UserID != c.GetUint("user_id") {
+		return 0
+	}
+	return rt.FamilyID
+}
+
+func userPermissions(user *models.User) []string {
+	permissions := []string{}
+	if user.Role == models.RoleAdmin && user.AdminRole != nil {
+		for _, p := range user.AdminRole.Permissions {
+			permissions = append(permissions, p.Slug)
+		}
+	}
+	return permissions
+}
+
+func requestDevice(c *gin.Context, name string) auth.Device {
+	return auth.Device{
+		Name:      deviceName(name, c.Request.UserAgent()),
+		UserAgent: truncate(c.Request.UserAgent(), 255),
+		IP:        c.ClientIP(),
+	}
+}
+
+// deviceName prefers the name sent by the app and falls back to a rough
+// label from the user agent.
+func deviceName(given, userAgent string) string {
+	if name := strings.TrimSpace(given); name != "" {
+		return truncate(name, 100)
+	}
+
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "android"):
+		return "Android"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		return "iOS"
+	case strings.Contains(ua, "windows"):
+		return "Windows"
+	case strings.Contains(ua, "mac os"):
+		return "Mac"
+	case strings.Contains(ua, "linux"):
+		return "Linux"
+	case ua == "":
+		return "Unknown device"
+	default:
+		return "Other"
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
 func (h *AuthHandler) Profile(c *gin.Context) {
 	userID := c.GetUint("user_id")
 	if userID == 0 {
@@ -275,6 +603,12 @@ func (h *AuthHandler) Profile(c *gin.Context) {
            }
         }
     }
+	wageHistory := []models.WageChangeLog{}
+	if user.Role != models.RoleAdmin {
+		if entries, err := h.WageLogRepo.ListByUser(user.ID, profileWageHistoryLimit); err == nil {
+			wageHistory = entries
+		}
+	}
 	c.JSON(http.StatusOK, gin.H{
 		"id":              user.ID,
 		"name":            user.Name,
@@ -289,6 +623,7 @@ func (h *AuthHandler) Profile(c *gin.Context) {
 		"joined_at":       user.JoinedAt,
 		"completed_work":  user.CompletedWork,
 		"current_wage":    user.CurrentWage,
+		"wage_history":    wageHistory,
 		"status":          user.Status,
 	})
 }
\ No newline at end of file
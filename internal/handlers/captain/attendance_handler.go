@@ -0,0 +1,65 @@
+package captain
+
+import (
+	"net/http"
+	"time"
+
+	"event-management-backend/internal/services/attendance"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CaptainAttendanceHandler struct {
+	service *attendance.AttendanceService
+}
+
+func NewCaptainAttendanceHandler(service *attendance.AttendanceService) *CaptainAttendanceHandler {
+	return &CaptainAttendanceHandler{service: service}
+}
+
+// ======================= CHECK-IN QR (OWN BOOKING) =======================
+func (h *CaptainAttendanceHandler) GetCheckInQR(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	bookingID := utils.ParseUintParam(c.Param("booking_id"))
+	if bookingID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid booking id"})
+		return
+	}
+
+	png, expires, err := h.service.CheckInQRCode(userID, bookingID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Token-Expires-At", expires.Format(time.RFC3339))
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// ======================= SCAN =======================
+func (h *CaptainAttendanceHandler) Scan(c *gin.Context) {
+	captainID := c.GetUint("user_id")
+
+	var req validations.ScanCheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.service.Scan(captainID, req.Token)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -36,6 +36,24 @@ func (h *CaptainBookingHandler) BookEvent(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{"message": "event booked successfully"})
 }
 
+// ======================= CANCEL BOOKING =======================
+func (h *CaptainBookingHandler) CancelBooking(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	bookingID := utils.ParseUintParam(c.Param("booking_id"))
+	if bookingID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid booking id"})
+		return
+	}
+
+	if err := h.service.CancelBooking(userID, bookingID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "booking cancelled"})
+}
+
 // ======================= TODAY =======================
 func (h *CaptainBookingHandler) ListTodayBookings(c *gin.Context) {
 	userID := c.GetUint("user_id")
@@ -109,13 +127,17 @@ func (h *CaptainBookingHandler) UpdateAttendance(c *gin.Context) {
         return
     }
 
+    if req.BonusAmount != 0 || req.FineAmount != 0 {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "apply fines and bonuses through rule_ids"})
+        return
+    }
+
     booking, err := h.service.UpdateAttendance(
         captainID,
         req.BookingID,
         req.Status,
         req.TAAmount,
-        req.BonusAmount,
-        req.FineAmount,
+        req.RuleIDs,
     )
     if err != nil {
         c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -188,4 +210,33 @@ func (h *CaptainBookingHandler) GetEventWageSummary(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, summary)
-}
\ No newline at end of file
+}
+// ======================= TA PROPOSALS =======================
+func (h *CaptainBookingHandler) ListTAProposals(c *gin.Context) {
+	captainID := c.GetUint("user_id")
+
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	proposals, err := h.service.ListTAProposals(captainID, eventID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, proposals)
+}
+
+// GET /captain/adjustment-rules
+func (h *CaptainBookingHandler) ListAdjustmentRules(c *gin.Context) {
+	rules, err := h.service.ListAdjustmentRules()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch adjustment rules"})
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
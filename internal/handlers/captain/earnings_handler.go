@@ -0,0 +1,35 @@
+package captain
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/services/earnings"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CaptainEarningsHandler struct {
+	service *earnings.EarningsService
+}
+
+func NewCaptainEarningsHandler(service *earnings.EarningsService) *CaptainEarningsHandler {
+	return &CaptainEarningsHandler{service: service}
+}
+
+// GET /captain/earnings?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *CaptainEarningsHandler) GetEarnings(c *gin.Context) {
+	from, to, err := utils.ParseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	statement, err := h.service.ForUser(c.GetUint("user_id"), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch earnings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
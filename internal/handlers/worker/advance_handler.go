@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/services/advances"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerAdvanceHandler struct {
+	ledger *advances.Ledger
+}
+
+func NewWorkerAdvanceHandler(ledger *advances.Ledger) *WorkerAdvanceHandler {
+	return &WorkerAdvanceHandler{ledger: ledger}
+}
+
+// ---------------- MY ADVANCES ----------------
+func (h *WorkerAdvanceHandler) GetMyAdvances(c *gin.Context) {
+	statement, err := h.ledger.StatementFor(c.GetUint("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch advances"})
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"net/http"
+	"time"
+
+	"event-management-backend/internal/services/attendance"
+	"event-management-backend/internal/utils"
+	"event-management-backend/internal/validations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerAttendanceHandler struct {
+	service *attendance.AttendanceService
+}
+
+func NewWorkerAttendanceHandler(service *attendance.AttendanceService) *WorkerAttendanceHandler {
+	return &WorkerAttendanceHandler{service: service}
+}
+
+// ---------------- CHECK-IN QR ----------------
+func (h *WorkerAttendanceHandler) GetCheckInQR(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	bookingID := utils.ParseUintParam(c.Param("booking_id"))
+	if bookingID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid booking id"})
+		return
+	}
+
+	png, expires, err := h.service.CheckInQRCode(userID, bookingID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Token-Expires-At", expires.Format(time.RFC3339))
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// ---------------- GEOFENCED CHECK-IN ----------------
+func (h *WorkerAttendanceHandler) SelfCheckIn(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	bookingID := utils.ParseUintParam(c.Param("booking_id"))
+	if bookingID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid booking id"})
+		return
+	}
+
+	var req validations.SelfCheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.service.SelfCheckIn(userID, bookingID, *req.Latitude, *req.Longitude)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -92,4 +92,23 @@ func (h *WorkerBookingHandler) GetBookingDetails(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, data)
-}
\ No newline at end of file
+}
+//
+// ---------------- CANCEL BOOKING ----------------
+//
+func (h *WorkerBookingHandler) CancelBooking(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	bookingID := utils.ParseUintParam(c.Param("booking_id"))
+	if bookingID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid booking id"})
+		return
+	}
+
+	if err := h.service.CancelBooking(userID, bookingID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "booking cancelled"})
+}
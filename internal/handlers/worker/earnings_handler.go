@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/services/earnings"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerEarningsHandler struct {
+	service *earnings.EarningsService
+}
+
+func NewWorkerEarningsHandler(service *earnings.EarningsService) *WorkerEarningsHandler {
+	return &WorkerEarningsHandler{service: service}
+}
+
+// GET /worker/earnings?from=YYYY-MM-DD&to=YYYY-MM-DD
+func (h *WorkerEarningsHandler) GetEarnings(c *gin.Context) {
+	from, to, err := utils.ParseDateRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	statement, err := h.service.ForUser(c.GetUint("user_id"), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch earnings"})
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
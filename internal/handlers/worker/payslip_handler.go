@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"event-management-backend/internal/services/payslip"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerPayslipHandler struct {
+	service *payslip.PayslipService
+}
+
+func NewWorkerPayslipHandler(service *payslip.PayslipService) *WorkerPayslipHandler {
+	return &WorkerPayslipHandler{service: service}
+}
+
+// ---------------- PAYSLIP FOR PAYROLL PERIOD ----------------
+func (h *WorkerPayslipHandler) GetPeriodPayslip(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	periodID := utils.ParseUintParam(c.Param("period"))
+	if periodID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period id"})
+		return
+	}
+
+	slip, err := h.service.ForPeriod(userID, periodID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	writePayslip(c, slip, fmt.Sprintf("period_%d", periodID))
+}
+
+// ---------------- PAYSLIP FOR SINGLE EVENT ----------------
+func (h *WorkerPayslipHandler) GetEventPayslip(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	slip, err := h.service.ForEvent(userID, eventID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	writePayslip(c, slip, fmt.Sprintf("event_%d", eventID))
+}
+
+func writePayslip(c *gin.Context, slip *payslip.Payslip, suffix string) {
+	var buf bytes.Buffer
+	if err := payslip.Render(&buf, slip); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate payslip"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, payslip.FileName(slip, suffix)))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"net/http"
+
+	"event-management-backend/internal/services/waitlist"
+	"event-management-backend/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkerWaitlistHandler struct {
+	service *waitlist.WaitlistService
+}
+
+func NewWorkerWaitlistHandler(service *waitlist.WaitlistService) *WorkerWaitlistHandler {
+	return &WorkerWaitlistHandler{service: service}
+}
+
+//
+// ---------------- JOIN WAITLIST ----------------
+//
+func (h *WorkerWaitlistHandler) JoinWaitlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+	role := c.GetString("role")
+
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	entry, err := h.service.Join(userID, eventID, role)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":  "added to waitlist",
+		"position": entry.Position,
+	})
+}
+
+//
+// ---------------- LEAVE WAITLIST ----------------
+//
+func (h *WorkerWaitlistHandler) LeaveWaitlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	eventID := utils.ParseUintParam(c.Param("event_id"))
+	if eventID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid event id"})
+		return
+	}
+
+	if err := h.service.Leave(userID, eventID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "removed from waitlist"})
+}
+
+//
+// ---------------- LIST MY WAITLIST ----------------
+//
+func (h *WorkerWaitlistHandler) ListMyWaitlist(c *gin.Context) {
+	userID := c.GetUint("user_id")
+
+	data, err := h.service.ListMine(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch waitlist"})
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
@@ -4,9 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
-	"time"
 
-	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/services/auth"
 	"event-management-backend/internal/utils"
 
@@ -14,7 +12,10 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func JWTAuthMiddleware(jwtService *auth.JWTService, refreshRepo interfaces.RefreshTokenRepository) gin.HandlerFunc {
+// JWTAuthMiddleware accepts a valid access token whose version still matches
+// the user's, so role edits, blocks and deletions apply within
+// auth.TokenVersionTTL.
+func JWTAuthMiddleware(jwtService *auth.JWTService, tokenVersions *auth.TokenVersionCache) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var accessToken string
 		header := c.GetHeader("Authorization")
@@ -28,65 +29,33 @@ func JWTAuthMiddleware(jwtService *auth.JWTService, refreshRepo interfaces.Refre
 		}
 		claims, err := jwtService.ValidateAccessToken(accessToken)
 		if err == nil {
+			if err := tokenVersions.Check(claims.UserID, claims.TokenVersion); err != nil {
+				if !errors.Is(err, auth.ErrTokenRevoked) {
+					c.JSON(http.StatusServiceUnavailable, gin.H{"error": "could not verify session"})
+					c.Abort()
+					return
+				}
+				// an active user gets the new role and permissions on refresh
+				utils.ClearAccessToken(c)
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "token revoked"})
+				c.Abort()
+				return
+			}
 			c.Set("user_id", claims.UserID)
 			c.Set("role", claims.Role)
 			c.Set("permissions", claims.Permissions)
+			c.Set("session_id", claims.SessionID)
 			c.Next()
 			return
 		}
-		if accessToken == "" || !errors.Is(err, jwt.ErrTokenExpired) {
-			utils.ClearAccessToken(c)
-			utils.ClearRefreshToken(c)
+		// the refresh cookie is left alone, the client renews the access
+		// token through POST /auth/refresh
+		utils.ClearAccessToken(c)
+		if accessToken != "" && errors.Is(err, jwt.ErrTokenExpired) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "token expired"})
+		} else {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
-			c.Abort()
-			return
-		}
-		rawRefresh, err := c.Cookie("refresh_token")
-		if err != nil || rawRefresh == "" {
-			utils.ClearAccessToken(c)
-			utils.ClearRefreshToken(c)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "login required"})
-			c.Abort()
-			return
-		}
-		expiredClaims, err := jwtService.ParseExpiredAccessToken(accessToken)
-		if err != nil || expiredClaims == nil {
-			utils.ClearAccessToken(c)
-			utils.ClearRefreshToken(c)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid access token"})
-			c.Abort()
-			return
-		}
-		hashed := utils.HashToken(rawRefresh)
-		rt, err := refreshRepo.FindByHashedToken(hashed)
-		if err != nil || rt.ExpiresAt.Before(time.Now().UTC()) {
-			utils.ClearAccessToken(c)
-			utils.ClearRefreshToken(c)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "session expired"})
-			c.Abort()
-			return
-		}
-		if rt.UserID != expiredClaims.UserID {
-			utils.ClearAccessToken(c)
-			utils.ClearRefreshToken(c)
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid refresh token user mismatch"})
-			c.Abort()
-			return
-		}
-		perms := expiredClaims.Permissions
-		if perms == nil {
-			perms = []string{}
-		}
-		newAccess, err := jwtService.GenerateAccessToken(expiredClaims.UserID, expiredClaims.Role, perms)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to generate access token"})
-			c.Abort()
-			return
 		}
-		utils.SetAccessToken(c, newAccess)
-		c.Set("user_id", expiredClaims.UserID)
-		c.Set("role", expiredClaims.Role)
-		c.Set("permissions", perms)
-		c.Next()
+		c.Abort()
 	}
 }
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ---------------- RULES ----------------
+
+type adjustmentRuleRepository struct{}
+
+func NewAdjustmentRuleRepository() interfaces.AdjustmentRuleRepository {
+	return &adjustmentRuleRepository{}
+}
+
+func (r *adjustmentRuleRepository) Create(rule *models.AdjustmentRule) error {
+	return config.DB.Create(rule).Error
+}
+
+func (r *adjustmentRuleRepository) Update(rule *models.AdjustmentRule) error {
+	return config.DB.Save(rule).Error
+}
+
+func (r *adjustmentRuleRepository) Delete(id uint) error {
+	res := config.DB.Delete(&models.AdjustmentRule{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *adjustmentRuleRepository) FindByID(id uint) (*models.AdjustmentRule, error) {
+	var rule models.AdjustmentRule
+	err := config.DB.Where("id = ?", id).First(&rule).Error
+	return &rule, err
+}
+
+func (r *adjustmentRuleRepository) ListAll(activeOnly bool) ([]models.AdjustmentRule, error) {
+	var rules []models.AdjustmentRule
+	q := config.DB.Model(&models.AdjustmentRule{})
+	if activeOnly {
+		q = q.Where("active = ?", true)
+	}
+	err := q.Order("kind ASC, name ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *adjustmentRuleRepository) FindActiveByIDsTx(tx *gorm.DB, ids []uint) ([]models.AdjustmentRule, error) {
+	var rules []models.AdjustmentRule
+	err := tx.
+		Where("id IN ? AND active = ?", ids, true).
+		Find(&rules).Error
+	return rules, err
+}
+
+// ---------------- BOOKING ADJUSTMENTS ----------------
+
+type bookingAdjustmentRepository struct{}
+
+func NewBookingAdjustmentRepository() interfaces.BookingAdjustmentRepository {
+	return &bookingAdjustmentRepository{}
+}
+
+func (r *bookingAdjustmentRepository) ListByBookingTx(tx *gorm.DB, bookingID uint) ([]models.BookingAdjustment, error) {
+	var items []models.BookingAdjustment
+	err := tx.
+		Where("booking_id = ?", bookingID).
+		Order("id ASC").
+		Find(&items).Error
+	return items, err
+}
+
+func (r *bookingAdjustmentRepository) CreateTx(tx *gorm.DB, items []models.BookingAdjustment) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return tx.Create(&items).Error
+}
+
+func (r *bookingAdjustmentRepository) DeleteByBookingTx(tx *gorm.DB, bookingID uint, rules, manual bool) error {
+	q := tx.Where("booking_id = ?", bookingID)
+	switch {
+	case rules && manual:
+	case rules:
+		q = q.Where("rule_id IS NOT NULL")
+	case manual:
+		q = q.Where("rule_id IS NULL AND kind <> ?", models.AdjustmentKindTA)
+	default:
+		return nil
+	}
+	return q.Delete(&models.BookingAdjustment{}).Error
+}
+
+func (r *bookingAdjustmentRepository) DeleteKindTx(tx *gorm.DB, bookingID uint, kind string) error {
+	return tx.
+		Where("booking_id = ? AND kind = ?", bookingID, kind).
+		Delete(&models.BookingAdjustment{}).Error
+}
+
+func (r *bookingAdjustmentRepository) FindByIDForUpdateTx(tx *gorm.DB, id uint) (*models.BookingAdjustment, error) {
+	var item models.BookingAdjustment
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&item).Error
+	return &item, err
+}
+
+func (r *bookingAdjustmentRepository) UpdateTx(tx *gorm.DB, item *models.BookingAdjustment) error {
+	return tx.Save(item).Error
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type advanceRepository struct{}
+
+func NewAdvanceRepository() interfaces.AdvanceRepository {
+	return &advanceRepository{}
+}
+
+func (r *advanceRepository) CreateTx(tx *gorm.DB, advance *models.Advance) error {
+	return tx.Create(advance).Error
+}
+
+func (r *advanceRepository) UpdateTx(tx *gorm.DB, advance *models.Advance) error {
+	return tx.Omit("User", "Repayments").Save(advance).Error
+}
+
+func (r *advanceRepository) FindByID(id uint) (*models.Advance, error) {
+	var advance models.Advance
+	err := config.DB.
+		Preload("User").
+		Preload("Repayments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where("id = ?", id).
+		First(&advance).Error
+	return &advance, err
+}
+
+func (r *advanceRepository) FindForUpdate(tx *gorm.DB, id uint) (*models.Advance, error) {
+	var advance models.Advance
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&advance).Error
+	return &advance, err
+}
+
+func (r *advanceRepository) List(userID uint, status string) ([]models.Advance, error) {
+	var advances []models.Advance
+	q := config.DB.
+		Preload("User").
+		Preload("Repayments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		})
+	if userID != 0 {
+		q = q.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("issued_at DESC, id DESC").Find(&advances).Error
+	return advances, err
+}
+
+func (r *advanceRepository) ListActiveForUpdate(tx *gorm.DB, userID uint) ([]models.Advance, error) {
+	var advances []models.Advance
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND status = ? AND balance > 0", userID, models.AdvanceStatusActive).
+		Order("issued_at ASC, id ASC").
+		Find(&advances).Error
+	return advances, err
+}
+
+func (r *advanceRepository) CreateRepaymentTx(tx *gorm.DB, repayment *models.AdvanceRepayment) error {
+	return tx.Create(repayment).Error
+}
+
+func (r *advanceRepository) Outstanding(userID uint) (int64, error) {
+	var total int64
+	err := config.DB.
+		Model(&models.Advance{}).
+		Select("COALESCE(SUM(balance),0)").
+		Where("user_id = ? AND status = ?", userID, models.AdvanceStatusActive).
+		Scan(&total).Error
+	return total, err
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type authRequestRepository struct{}
+
+func NewAuthRequestRepository() interfaces.AuthRequestRepository {
+	return &authRequestRepository{}
+}
+
+func (r *authRequestRepository) Create(request *models.AuthRequest) error {
+	return config.DB.Create(request).Error
+}
+
+func (r *authRequestRepository) CountByPhoneSince(kind, phone string, since time.Time) (int64, error) {
+	var count int64
+	err := config.DB.Model(&models.AuthRequest{}).
+		Where("kind = ? AND phone = ? AND created_at >= ?", kind, phone, since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *authRequestRepository) CountByIPSince(kind, ip string, since time.Time) (int64, error) {
+	var count int64
+	err := config.DB.Model(&models.AuthRequest{}).
+		Where("kind = ? AND ip = ? AND created_at >= ?", kind, ip, since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *authRequestRepository) LatestByPhone(kind, phone string) (*time.Time, error) {
+	var request models.AuthRequest
+	err := config.DB.
+		Where("kind = ? AND phone = ?", kind, phone).
+		Order("created_at DESC").
+		First(&request).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &request.CreatedAt, nil
+}
+
+func (r *authRequestRepository) PurgeBefore(before time.Time) (int64, error) {
+	res := config.DB.Where("created_at < ?", before).Delete(&models.AuthRequest{})
+	return res.RowsAffected, res.Error
+}
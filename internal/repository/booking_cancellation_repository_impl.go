@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type bookingCancellationRepository struct{}
+
+func NewBookingCancellationRepository() interfaces.BookingCancellationRepository {
+	return &bookingCancellationRepository{}
+}
+
+func (r *bookingCancellationRepository) CreateTx(
+	tx *gorm.DB,
+	cancellation *models.BookingCancellation,
+) error {
+	return tx.Create(cancellation).Error
+}
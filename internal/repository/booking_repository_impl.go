@@ -37,9 +37,17 @@ func (r *bookingRepository) FindByEventAndUser(
 	eventID uint,
 	userID uint,
 ) (*models.Booking, error) {
+	return r.FindByEventAndUserTx(config.DB, eventID, userID)
+}
+
+func (r *bookingRepository) FindByEventAndUserTx(
+	tx *gorm.DB,
+	eventID uint,
+	userID uint,
+) (*models.Booking, error) {
 
 	var booking models.Booking
-	err := config.DB.
+	err := tx.
 		Where(
 			"event_id = ? AND user_id = ? AND deleted_at IS NULL",
 			eventID,
@@ -62,6 +70,20 @@ func (r *bookingRepository) ListByUser(userID uint) ([]models.Booking, error) {
 	return bookings, err
 }
 
+//---------------- LIST BY PAYOUT ----------------
+
+func (r *bookingRepository) ListByPayout(payoutID uint) ([]models.Booking, error) {
+	var bookings []models.Booking
+	err := config.DB.
+		Preload("Event").
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Where("bookings.payout_id = ? AND bookings.deleted_at IS NULL", payoutID).
+		Order("events.date ASC").
+		Find(&bookings).Error
+
+	return bookings, err
+}
+
 //---------------- LIST BY EVENT ----------------
 
 func (r *bookingRepository) ListByEvent(eventID uint) ([]models.Booking, error) {
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+type checkInAttemptRepository struct{}
+
+func NewCheckInAttemptRepository() interfaces.CheckInAttemptRepository {
+	return &checkInAttemptRepository{}
+}
+
+func (r *checkInAttemptRepository) Create(attempt *models.CheckInAttempt) error {
+	return config.DB.Create(attempt).Error
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+type eventLifecycleLogRepository struct{}
+
+func NewEventLifecycleLogRepository() interfaces.EventLifecycleLogRepository {
+	return &eventLifecycleLogRepository{}
+}
+
+func (r *eventLifecycleLogRepository) Create(log *models.EventLifecycleLog) error {
+	return config.DB.Omit("Event").Create(log).Error
+}
+
+func (r *eventLifecycleLogRepository) Exists(eventID uint, action string) (bool, error) {
+	var count int64
+	err := config.DB.Model(&models.EventLifecycleLog{}).
+		Where("event_id = ? AND action = ?", eventID, action).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *eventLifecycleLogRepository) List(eventID uint, action string) ([]models.EventLifecycleLog, error) {
+	var logs []models.EventLifecycleLog
+	q := config.DB.Preload("Event")
+	if eventID != 0 {
+		q = q.Where("event_id = ?", eventID)
+	}
+	if action != "" {
+		q = q.Where("action = ?", action)
+	}
+	err := q.Order("created_at DESC").Limit(500).Find(&logs).Error
+	return logs, err
+}
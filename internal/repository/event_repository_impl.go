@@ -21,20 +21,35 @@ func (r *eventRepository) Create(event *models.Event) error {
 	return config.DB.Create(event).Error
 }
 
+func (r *eventRepository) CreateTx(tx *gorm.DB, event *models.Event) error {
+	return tx.Create(event).Error
+}
+
 func (r *eventRepository) FindByID(id uint) (*models.Event, error) {
 	var event models.Event
 	err := config.DB.
+		Preload("RoleRequirements", orderByRole).
 		Where("id = ? AND deleted_at IS NULL", id).
 		First(&event).Error
 	return &event, err
 }
 
+// FindByIDForUpdate locks the event row. Role requirements are only changed
+// while holding this lock, so they are loaded without a lock of their own.
 func (r *eventRepository) FindByIDForUpdate(tx *gorm.DB, id uint) (*models.Event, error) {
 	var event models.Event
 	err := tx.
 		Clauses(clause.Locking{Strength: "UPDATE"}).
 		Where("id = ? AND deleted_at IS NULL", id).
 		First(&event).Error
+	if err != nil {
+		return &event, err
+	}
+
+	err = tx.
+		Where("event_id = ?", event.ID).
+		Order("role ASC").
+		Find(&event.RoleRequirements).Error
 	return &event, err
 }
 
@@ -55,35 +70,15 @@ func (r *eventRepository) ListAll(status string, date string) ([]models.Event, e
 		}
 	}
 
-	err := q.Order("date ASC").Find(&events).Error
+	err := q.Preload("RoleRequirements", orderByRole).Order("date ASC").Find(&events).Error
 	return events, err
 }
 
-// ---------------- CAPTAIN ----------------
-func (r *eventRepository) ListAvailableForCaptain(userID uint, date time.Time) ([]models.Event, error) {
-	return r.listAvailableByRole(userID, date, "remaining_captains > 0")
-}
-
-// ---------------- SUB CAPTAIN ----------------
-func (r *eventRepository) ListAvailableForSubCaptain(userID uint, date time.Time) ([]models.Event, error) {
-	return r.listAvailableByRole(userID, date, "remaining_sub_captains > 0")
-}
-
-// ---------------- MAIN BOY ----------------
-func (r *eventRepository) ListAvailableForMainBoy(userID uint, date time.Time) ([]models.Event, error) {
-	return r.listAvailableByRole(userID, date, "remaining_main_boys > 0")
-}
-
-// ---------------- JUNIOR ----------------
-func (r *eventRepository) ListAvailableForJunior(userID uint, date time.Time) ([]models.Event, error) {
-	return r.listAvailableByRole(userID, date, "remaining_juniors > 0")
-}
-
-// ---------------- COMMON INTERNAL QUERY ----------------
-func (r *eventRepository) listAvailableByRole(
+// ---------------- AVAILABLE FOR ROLE ----------------
+func (r *eventRepository) ListAvailableForRole(
 	userID uint,
+	role string,
 	date time.Time,
-	roleCondition string,
 ) ([]models.Event, error) {
 
 	var events []models.Event
@@ -91,7 +86,14 @@ func (r *eventRepository) listAvailableByRole(
     q := config.DB.Model(&models.Event{}).
         Where("events.deleted_at IS NULL").
         Where("events.status = ?", models.EventStatusUpcoming).
-        Where(roleCondition)
+        Where(`
+        EXISTS (
+            SELECT 1 FROM event_role_requirements r
+            WHERE r.event_id = events.id
+            AND r.role = ?
+            AND r.remaining > 0
+        )
+    `, role)
 
     q = q.Where(`
         NOT EXISTS (
@@ -117,12 +119,27 @@ func (r *eventRepository) listAvailableByRole(
         q = q.Where("DATE(events.date) >= DATE(?)", date)
     }
 
-    err := q.Order("events.date ASC").Find(&events).Error
+    err := q.Preload("RoleRequirements", orderByRole).Order("events.date ASC").Find(&events).Error
     return events, err
 }
 
 func (r *eventRepository) Update(event *models.Event) error {
-	return config.DB.Save(event).Error
+	return config.DB.Omit("RoleRequirements").Save(event).Error
+}
+
+// SaveTx saves the event together with its role requirement counters.
+func (r *eventRepository) SaveTx(tx *gorm.DB, event *models.Event) error {
+	if err := tx.Omit("RoleRequirements").Save(event).Error; err != nil {
+		return err
+	}
+	for i := range event.RoleRequirements {
+		req := &event.RoleRequirements[i]
+		req.EventID = event.ID
+		if err := tx.Save(req).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r *eventRepository) SoftDelete(id uint) error {
@@ -131,4 +148,8 @@ func (r *eventRepository) SoftDelete(id uint) error {
 		return gorm.ErrRecordNotFound
 	}
 	return res.Error
-}
\ No newline at end of file
+}
+
+func orderByRole(db *gorm.DB) *gorm.DB {
+	return db.Order("role ASC")
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type eventSeriesRepository struct{}
+
+func NewEventSeriesRepository() interfaces.EventSeriesRepository {
+	return &eventSeriesRepository{}
+}
+
+func (r *eventSeriesRepository) CreateTx(tx *gorm.DB, series *models.EventSeries) error {
+	return tx.Omit("Events").Create(series).Error
+}
+
+func (r *eventSeriesRepository) FindByID(id uint) (*models.EventSeries, error) {
+	var series models.EventSeries
+	err := config.DB.
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("date ASC")
+		}).
+		Preload("Events.RoleRequirements", orderByRole).
+		Where("id = ?", id).
+		First(&series).Error
+	return &series, err
+}
+
+func (r *eventSeriesRepository) ListAll(status string) ([]models.EventSeries, error) {
+	var series []models.EventSeries
+	q := config.DB.Model(&models.EventSeries{})
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("start_date DESC").Find(&series).Error
+	return series, err
+}
+
+func (r *eventSeriesRepository) UpdateTx(tx *gorm.DB, series *models.EventSeries) error {
+	return tx.Omit("Events").Save(series).Error
+}
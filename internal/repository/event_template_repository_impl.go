@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type eventTemplateRepository struct{}
+
+func NewEventTemplateRepository() interfaces.EventTemplateRepository {
+	return &eventTemplateRepository{}
+}
+
+func (r *eventTemplateRepository) Create(template *models.EventTemplate) error {
+	return config.DB.Create(template).Error
+}
+
+func (r *eventTemplateRepository) NameTaken(name string, excludeID uint) (bool, error) {
+	var count int64
+	err := config.DB.Model(&models.EventTemplate{}).
+		Where("name = ? AND id <> ?", name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *eventTemplateRepository) FindByID(id uint) (*models.EventTemplate, error) {
+	var template models.EventTemplate
+	err := config.DB.
+		Preload("Roles", orderByRole).
+		Where("id = ?", id).
+		First(&template).Error
+	return &template, err
+}
+
+func (r *eventTemplateRepository) ListAll() ([]models.EventTemplate, error) {
+	var templates []models.EventTemplate
+	err := config.DB.
+		Preload("Roles", orderByRole).
+		Order("name ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+// Update saves the template and replaces its role rows.
+func (r *eventTemplateRepository) Update(template *models.EventTemplate) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Roles").Save(template).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("template_id = ?", template.ID).Delete(&models.EventTemplateRole{}).Error; err != nil {
+			return err
+		}
+		for i := range template.Roles {
+			template.Roles[i].ID = 0
+			template.Roles[i].TemplateID = template.ID
+		}
+		if len(template.Roles) == 0 {
+			return nil
+		}
+		return tx.Create(&template.Roles).Error
+	})
+}
+
+func (r *eventTemplateRepository) Delete(id uint) error {
+	res := config.DB.Delete(&models.EventTemplate{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
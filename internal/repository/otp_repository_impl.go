@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type otpRepository struct{}
+
+func NewOTPRepository() interfaces.OTPRepository {
+	return &otpRepository{}
+}
+
+func (r *otpRepository) Create(code *models.OTPCode) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.OTPCode{}).
+			Where("phone = ? AND consumed_at IS NULL", code.Phone).
+			Update("consumed_at", time.Now().UTC()).Error; err != nil {
+			return err
+		}
+		return tx.Create(code).Error
+	})
+}
+
+func (r *otpRepository) FindActiveForUpdateTx(tx *gorm.DB, phone string) (*models.OTPCode, error) {
+	var code models.OTPCode
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("phone = ? AND consumed_at IS NULL", phone).
+		Order("created_at DESC").
+		First(&code).Error
+	return &code, err
+}
+
+func (r *otpRepository) UpdateTx(tx *gorm.DB, code *models.OTPCode) error {
+	return tx.Save(code).Error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type passwordResetRepository struct{}
+
+func NewPasswordResetRepository() interfaces.PasswordResetRepository {
+	return &passwordResetRepository{}
+}
+
+func (r *passwordResetRepository) Create(token *models.PasswordResetToken) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.PasswordResetToken{}).
+			Where("user_id = ? AND used_at IS NULL", token.UserID).
+			Update("used_at", time.Now().UTC()).Error; err != nil {
+			return err
+		}
+		return tx.Create(token).Error
+	})
+}
+
+func (r *passwordResetRepository) FindByHashedForUpdateTx(tx *gorm.DB, hashed string) (*models.PasswordResetToken, error) {
+	var token models.PasswordResetToken
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hashed = ?", hashed).
+		First(&token).Error
+	return &token, err
+}
+
+func (r *passwordResetRepository) MarkUsedTx(tx *gorm.DB, id uint) error {
+	return tx.Model(&models.PasswordResetToken{}).
+		Where("id = ?", id).
+		Update("used_at", time.Now().UTC()).Error
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type payrollRepository struct{}
+
+func NewPayrollRepository() interfaces.PayrollRepository {
+	return &payrollRepository{}
+}
+
+// ---------------- PERIODS ----------------
+
+func (r *payrollRepository) CreatePeriod(period *models.PayrollPeriod) error {
+	return config.DB.Create(period).Error
+}
+
+func (r *payrollRepository) FindPeriodByID(id uint) (*models.PayrollPeriod, error) {
+	var period models.PayrollPeriod
+	err := config.DB.Where("id = ?", id).First(&period).Error
+	return &period, err
+}
+
+func (r *payrollRepository) FindPeriodForUpdate(tx *gorm.DB, id uint) (*models.PayrollPeriod, error) {
+	var period models.PayrollPeriod
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&period).Error
+	return &period, err
+}
+
+func (r *payrollRepository) ListPeriods(status string) ([]models.PayrollPeriod, error) {
+	var periods []models.PayrollPeriod
+	q := config.DB.Model(&models.PayrollPeriod{})
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("start_date DESC").Find(&periods).Error
+	return periods, err
+}
+
+// HasOverlap reports whether any period shares a day with [start, end].
+func (r *payrollRepository) HasOverlap(start, end time.Time) (bool, error) {
+	var count int64
+	err := config.DB.Model(&models.PayrollPeriod{}).
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *payrollRepository) UpdatePeriodTx(tx *gorm.DB, period *models.PayrollPeriod) error {
+	return tx.Omit("Payouts").Save(period).Error
+}
+
+// ---------------- PAYOUTS ----------------
+
+func (r *payrollRepository) CreatePayoutTx(tx *gorm.DB, payout *models.Payout) error {
+	return tx.Omit("User").Create(payout).Error
+}
+
+func (r *payrollRepository) ListPayouts(periodID uint, status string) ([]models.Payout, error) {
+	var payouts []models.Payout
+	q := config.DB.
+		Preload("User").
+		Where("period_id = ?", periodID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("total_amount DESC").Find(&payouts).Error
+	return payouts, err
+}
+
+func (r *payrollRepository) FindPayoutByID(id uint) (*models.Payout, error) {
+	var payout models.Payout
+	err := config.DB.
+		Preload("User").
+		Preload("Items.Event").
+		Where("id = ?", id).
+		First(&payout).Error
+	return &payout, err
+}
+
+func (r *payrollRepository) FindPayoutByPeriodAndUser(periodID, userID uint) (*models.Payout, error) {
+	var payout models.Payout
+	err := config.DB.
+		Where("period_id = ? AND user_id = ?", periodID, userID).
+		First(&payout).Error
+	return &payout, err
+}
+
+func (r *payrollRepository) FindPayoutForUpdate(tx *gorm.DB, id uint) (*models.Payout, error) {
+	var payout models.Payout
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&payout).Error
+	return &payout, err
+}
+
+func (r *payrollRepository) UpdatePayoutTx(tx *gorm.DB, payout *models.Payout) error {
+	return tx.Omit("User", "Items").Save(payout).Error
+}
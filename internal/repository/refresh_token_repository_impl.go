@@ -1,9 +1,14 @@
 package repository
 
 import (
+	"time"
+
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type refreshTokenRepository struct{}
@@ -13,25 +18,81 @@ func NewRefreshTokenRepository() interfaces.RefreshTokenRepository {
 }
 
 func (r *refreshTokenRepository) Save(t *models.RefreshToken) error {
-	err := config.DB.Where("user_id = ?", t.UserID).Delete(&models.RefreshToken{}).Error
-	if err != nil {
-		return err
+	if t.FamilyID != 0 {
+		return config.DB.Create(t).Error
 	}
-	return config.DB.Create(t).Error
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(t).Error; err != nil {
+			return err
+		}
+		t.FamilyID = t.ID
+		return tx.Model(t).Update("family_id", t.ID).Error
+	})
 }
 
-func (r *refreshTokenRepository) FindByUserID(userID uint) (*models.RefreshToken, error) {
+func (r *refreshTokenRepository) FindByHashedToken(hashed string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
-	err := config.DB.Where("user_id = ?", userID).First(&token).Error
+	err := config.DB.Where("token_hashed = ?", hashed).First(&token).Error
 	return &token, err
 }
 
-func (r *refreshTokenRepository) FindByHashedToken(hashed string) (*models.RefreshToken, error) {
+func (r *refreshTokenRepository) FindByHashedTokenForUpdateTx(tx *gorm.DB, hashed string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
-	err := config.DB.Where("token_hashed = ?", hashed).First(&token).Error
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hashed = ?", hashed).
+		First(&token).Error
 	return &token, err
 }
 
+func (r *refreshTokenRepository) RotateTx(tx *gorm.DB, old, next *models.RefreshToken) error {
+	if err := tx.Create(next).Error; err != nil {
+		return err
+	}
+	return tx.Model(&models.RefreshToken{}).
+		Where("id = ?", old.ID).
+		Updates(map[string]interface{}{
+			"rotated_at":     time.Now().UTC(),
+			"replaced_by_id": next.ID,
+		}).Error
+}
+
+func (r *refreshTokenRepository) ListActiveByUserID(userID uint) ([]models.RefreshToken, error) {
+	var tokens []models.RefreshToken
+	err := config.DB.
+		Where("user_id = ? AND rotated_at IS NULL AND expires_at > ?", userID, time.Now().UTC()).
+		Order("COALESCE(last_used_at, created_at) DESC").
+		Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *refreshTokenRepository) DeleteFamily(userID, familyID uint) error {
+	res := config.DB.
+		Where("family_id = ? AND user_id = ?", familyID, userID).
+		Delete(&models.RefreshToken{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 func (r *refreshTokenRepository) DeleteByUserID(userID uint) error {
 	return config.DB.Where("user_id = ?", userID).Delete(&models.RefreshToken{}).Error
 }
+
+func (r *refreshTokenRepository) DeleteOtherFamilies(userID, keepFamilyID uint) error {
+	return config.DB.
+		Where("user_id = ? AND family_id <> ?", userID, keepFamilyID).
+		Delete(&models.RefreshToken{}).Error
+}
+
+func (r *refreshTokenRepository) PurgeStale(before time.Time) (int64, error) {
+	// rotated tokens expire no later than their replacement was issued plus
+	// the TTL, so the expiry check covers them as well
+	res := config.DB.Unscoped().
+		Where("expires_at < ? OR deleted_at < ?", before, before).
+		Delete(&models.RefreshToken{})
+	return res.RowsAffected, res.Error
+}
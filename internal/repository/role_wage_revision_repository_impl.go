@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type roleWageRevisionRepository struct{}
+
+func NewRoleWageRevisionRepository() interfaces.RoleWageRevisionRepository {
+	return &roleWageRevisionRepository{}
+}
+
+func (r *roleWageRevisionRepository) CreateTx(tx *gorm.DB, revision *models.RoleWageRevision) error {
+	return tx.Create(revision).Error
+}
+
+func (r *roleWageRevisionRepository) FindByID(id uint) (*models.RoleWageRevision, error) {
+	var revision models.RoleWageRevision
+	err := config.DB.Where("id = ?", id).First(&revision).Error
+	return &revision, err
+}
+
+func (r *roleWageRevisionRepository) FindByRoleAndDate(role string, effectiveFrom time.Time) (*models.RoleWageRevision, error) {
+	var revision models.RoleWageRevision
+	err := config.DB.
+		Where("role = ? AND DATE(effective_from) = DATE(?)", role, effectiveFrom).
+		First(&revision).Error
+	return &revision, err
+}
+
+func (r *roleWageRevisionRepository) ListByRole(role string) ([]models.RoleWageRevision, error) {
+	var revisions []models.RoleWageRevision
+	err := config.DB.
+		Where("role = ?", role).
+		Order("effective_from DESC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+func (r *roleWageRevisionRepository) Delete(id uint) error {
+	res := config.DB.Delete(&models.RoleWageRevision{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *roleWageRevisionRepository) FindEffectiveTx(tx *gorm.DB, role string, date time.Time) (*models.RoleWageRevision, error) {
+	var revision models.RoleWageRevision
+	err := tx.
+		Where("role = ? AND DATE(effective_from) <= DATE(?)", role, date).
+		Order("effective_from DESC").
+		First(&revision).Error
+	return &revision, err
+}
+
+func (r *roleWageRevisionRepository) ListDue(date time.Time) ([]models.RoleWageRevision, error) {
+	var revisions []models.RoleWageRevision
+	err := config.DB.
+		Where("applied = ? AND DATE(effective_from) <= DATE(?)", false, date).
+		Order("effective_from ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+func (r *roleWageRevisionRepository) UpdateTx(tx *gorm.DB, revision *models.RoleWageRevision) error {
+	return tx.Save(revision).Error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+type securityEventRepository struct{}
+
+func NewSecurityEventRepository() interfaces.SecurityEventRepository {
+	return &securityEventRepository{}
+}
+
+func (r *securityEventRepository) Create(event *models.SecurityEvent) error {
+	return config.DB.Create(event).Error
+}
+
+func (r *securityEventRepository) List(userID uint, kind string) ([]models.SecurityEvent, error) {
+	var events []models.SecurityEvent
+	q := config.DB.Model(&models.SecurityEvent{})
+	if userID != 0 {
+		q = q.Where("user_id = ?", userID)
+	}
+	if kind != "" {
+		q = q.Where("kind = ?", kind)
+	}
+	err := q.Order("created_at DESC").Limit(500).Find(&events).Error
+	return events, err
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+type settingRepository struct{}
+
+func NewSettingRepository() interfaces.SettingRepository {
+	return &settingRepository{}
+}
+
+func (r *settingRepository) FindByKey(key string) (*models.SystemSetting, error) {
+	var setting models.SystemSetting
+	err := config.DB.Where("key = ?", key).First(&setting).Error
+	return &setting, err
+}
+
+// GetInt returns the setting parsed as an integer, or fallback when it is
+// missing or not a number.
+func (r *settingRepository) GetInt(key string, fallback int64) int64 {
+	setting, err := r.FindByKey(key)
+	if err != nil {
+		return fallback
+	}
+	v, err := strconv.ParseInt(strings.TrimSpace(setting.Value), 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// GetBool returns the setting parsed as a boolean ("true"/"false"), or
+// fallback when it is missing or not a boolean.
+func (r *settingRepository) GetBool(key string, fallback bool) bool {
+	setting, err := r.FindByKey(key)
+	if err != nil {
+		return fallback
+	}
+	v, err := strconv.ParseBool(strings.TrimSpace(setting.Value))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"strings"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// ---------------- STARTING POINTS ----------------
+
+type startingPointRepository struct{}
+
+func NewStartingPointRepository() interfaces.StartingPointRepository {
+	return &startingPointRepository{}
+}
+
+func (r *startingPointRepository) Create(point *models.StartingPoint) error {
+	return config.DB.Create(point).Error
+}
+
+func (r *startingPointRepository) Update(point *models.StartingPoint) error {
+	return config.DB.Save(point).Error
+}
+
+func (r *startingPointRepository) Delete(id uint) error {
+	res := config.DB.Delete(&models.StartingPoint{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *startingPointRepository) FindByID(id uint) (*models.StartingPoint, error) {
+	var point models.StartingPoint
+	err := config.DB.Where("id = ?", id).First(&point).Error
+	return &point, err
+}
+
+func (r *startingPointRepository) FindByNameTx(tx *gorm.DB, name string) (*models.StartingPoint, error) {
+	var point models.StartingPoint
+	err := tx.
+		Where("LOWER(name) = ?", strings.ToLower(strings.TrimSpace(name))).
+		First(&point).Error
+	return &point, err
+}
+
+func (r *startingPointRepository) ListAll() ([]models.StartingPoint, error) {
+	var points []models.StartingPoint
+	err := config.DB.Order("name ASC").Find(&points).Error
+	return points, err
+}
+
+// ---------------- TA POLICIES ----------------
+
+type taPolicyRepository struct{}
+
+func NewTAPolicyRepository() interfaces.TAPolicyRepository {
+	return &taPolicyRepository{}
+}
+
+func orderByUpToKm(db *gorm.DB) *gorm.DB {
+	return db.Order("up_to_km ASC")
+}
+
+func (r *taPolicyRepository) Create(policy *models.TAPolicy) error {
+	return config.DB.Create(policy).Error
+}
+
+// Update saves the policy and replaces its bands.
+func (r *taPolicyRepository) Update(policy *models.TAPolicy) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Bands").Save(policy).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("policy_id = ?", policy.ID).Delete(&models.TABand{}).Error; err != nil {
+			return err
+		}
+		for i := range policy.Bands {
+			policy.Bands[i].ID = 0
+			policy.Bands[i].PolicyID = policy.ID
+		}
+		if len(policy.Bands) == 0 {
+			return nil
+		}
+		return tx.Create(&policy.Bands).Error
+	})
+}
+
+func (r *taPolicyRepository) Delete(id uint) error {
+	res := config.DB.Delete(&models.TAPolicy{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *taPolicyRepository) FindByID(id uint) (*models.TAPolicy, error) {
+	var policy models.TAPolicy
+	err := config.DB.
+		Preload("Bands", orderByUpToKm).
+		Where("id = ?", id).
+		First(&policy).Error
+	return &policy, err
+}
+
+func (r *taPolicyRepository) FindByTransportTx(tx *gorm.DB, transportType string) (*models.TAPolicy, error) {
+	var policy models.TAPolicy
+	err := tx.
+		Preload("Bands", orderByUpToKm).
+		Where("transport_type = ?", transportType).
+		First(&policy).Error
+	return &policy, err
+}
+
+func (r *taPolicyRepository) ListAll() ([]models.TAPolicy, error) {
+	var policies []models.TAPolicy
+	err := config.DB.
+		Preload("Bands", orderByUpToKm).
+		Order("transport_type ASC").
+		Find(&policies).Error
+	return policies, err
+}
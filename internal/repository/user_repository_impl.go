@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
@@ -27,6 +29,17 @@ func (r *userRepository) FindByID(id uint) (*models.User, error) {
 	return &user, err
 }
 
+func (r *userRepository) FindByIDs(ids []uint) ([]models.User, error) {
+	var users []models.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := config.DB.
+		Where("id IN ? AND deleted_at IS NULL", ids).
+		Find(&users).Error
+	return users, err
+}
+
 func (r *userRepository) FindByPhone(phone string) (*models.User, error) {
 	var user models.User
 	err := config.DB.
@@ -40,6 +53,7 @@ func (r *userRepository) ListAll(role string, status string) ([]models.User, err
 	var users []models.User
 	query := config.DB.Model(&models.User{}).
              Preload("AdminRole"). 
+             Preload("WageOverride", activeOverride()).
              Where("deleted_at IS NULL") 
 
 	if role != "" {
@@ -56,6 +70,7 @@ func (r *userRepository) ListByRole(role string) ([]models.User, error) {
     var users []models.User
     err := config.DB.
         Preload("AdminRole"). 
+        Preload("WageOverride", activeOverride()).
         Where("role = ? AND deleted_at IS NULL", role).
         Order("created_at DESC").
         Find(&users).Error
@@ -65,6 +80,7 @@ func (r *userRepository) SearchByPhone(phone string) ([]models.User, error) {
     var users []models.User
     err := config.DB.
         Preload("AdminRole"). 
+        Preload("WageOverride", activeOverride()).
         Where("phone ILIKE ? AND deleted_at IS NULL", "%"+phone+"%").
         Order("created_at DESC").
         Find(&users).Error
@@ -91,8 +107,8 @@ func (r *userRepository) Count() (int64, error) {
 func (r *userRepository) Update(user *models.User) error {
 	return config.DB.Save(user).Error
 }
-func (r *userRepository) UpdateRole(user *models.User) error {
- return config.DB.Model(user).Select("Role", "AdminRoleID", "CurrentWage", "UpdatedAt").Updates(user).Error
+func (r *userRepository) UpdateRoleTx(tx *gorm.DB, user *models.User) error {
+ return tx.Model(user).Select("Role", "AdminRoleID", "UpdatedAt").Updates(user).Error
 }
 func (r *userRepository) RemovePhoto(id uint) error {
     return config.DB.Model(&models.User{}).Where("id = ?", id).Update("photo", "").Error
@@ -114,4 +130,40 @@ func (r *userRepository) UpdateWageByRole(role string, wage int64) error {
 
 func (r *userRepository) SoftDelete(id uint) error {
 	return config.DB.Delete(&models.User{}, id).Error
-}
\ No newline at end of file
+}
+
+func (r *userRepository) FindTokenState(id uint) (*models.User, error) {
+	var user models.User
+	err := config.DB.
+		Select("id", "status", "token_version").
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&user).Error
+	return &user, err
+}
+
+func (r *userRepository) BumpTokenVersion(id uint) error {
+	return config.DB.Exec(
+		"UPDATE users SET token_version = token_version + 1 WHERE id = ?", id,
+	).Error
+}
+
+func (r *userRepository) BumpTokenVersionByAdminRole(adminRoleID uint) error {
+	return config.DB.Exec(
+		"UPDATE users SET token_version = token_version + 1 WHERE admin_role_id = ? AND deleted_at IS NULL", adminRoleID,
+	).Error
+}
+
+func (r *userRepository) SetPasswordTx(tx *gorm.DB, id uint, hashed string) error {
+	return tx.Exec(
+		"UPDATE users SET password = ?, token_version = token_version + 1, updated_at = NOW() WHERE id = ? AND deleted_at IS NULL",
+		hashed, id,
+	).Error
+}
+
+// activeOverride limits the preloaded wage override to one still in force.
+func activeOverride() func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		now := time.Now()
+		return db.Where("starts_at <= ? AND (expires_at IS NULL OR expires_at > ?)", now, now)
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type wageOverrideRepository struct{}
+
+func NewWageOverrideRepository() interfaces.WageOverrideRepository {
+	return &wageOverrideRepository{}
+}
+
+func (r *wageOverrideRepository) FindByUser(userID uint) (*models.WageOverride, error) {
+	var override models.WageOverride
+	err := config.DB.
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).
+		Order("starts_at DESC, id DESC").
+		First(&override).Error
+	return &override, err
+}
+
+func (r *wageOverrideRepository) FindActiveTx(tx *gorm.DB, userID uint, t time.Time) (*models.WageOverride, error) {
+	var override models.WageOverride
+	err := tx.
+		Where("user_id = ? AND starts_at <= ? AND (expires_at IS NULL OR expires_at > ?)", userID, t, t).
+		Order("starts_at DESC, id DESC").
+		First(&override).Error
+	return &override, err
+}
+
+func (r *wageOverrideRepository) ListActiveByUsersTx(tx *gorm.DB, userIDs []uint, t time.Time) ([]models.WageOverride, error) {
+	var overrides []models.WageOverride
+	err := tx.
+		Where("user_id IN ? AND starts_at <= ? AND (expires_at IS NULL OR expires_at > ?)", userIDs, t, t).
+		Order("starts_at DESC, id DESC").
+		Find(&overrides).Error
+	return overrides, err
+}
+
+func (r *wageOverrideRepository) SaveTx(tx *gorm.DB, override *models.WageOverride) error {
+	return tx.Save(override).Error
+}
+
+func (r *wageOverrideRepository) ExpireTx(tx *gorm.DB, userID uint, at time.Time) error {
+	res := tx.Model(&models.WageOverride{}).
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).
+		Updates(map[string]interface{}{
+			"expires_at":  at,
+			"released_at": time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *wageOverrideRepository) ListExpired(now time.Time) ([]models.WageOverride, error) {
+	var overrides []models.WageOverride
+	err := config.DB.
+		Where("expires_at IS NOT NULL AND expires_at <= ? AND released_at IS NULL", now).
+		Find(&overrides).Error
+	return overrides, err
+}
+
+func (r *wageOverrideRepository) MarkReleasedTx(tx *gorm.DB, id uint, at time.Time) error {
+	return tx.Model(&models.WageOverride{}).
+		Where("id = ?", id).
+		Update("released_at", at).Error
+}
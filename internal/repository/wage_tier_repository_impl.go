@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type wageTierRepository struct{}
+
+func NewWageTierRepository() interfaces.WageTierRepository {
+	return &wageTierRepository{}
+}
+
+func (r *wageTierRepository) CreateTx(tx *gorm.DB, tier *models.WageTier) error {
+	return tx.Create(tier).Error
+}
+
+func (r *wageTierRepository) UpdateTx(tx *gorm.DB, tier *models.WageTier) error {
+	return tx.Save(tier).Error
+}
+
+func (r *wageTierRepository) DeleteTx(tx *gorm.DB, id uint) error {
+	res := tx.Delete(&models.WageTier{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *wageTierRepository) FindByID(id uint) (*models.WageTier, error) {
+	var tier models.WageTier
+	err := config.DB.Where("id = ?", id).First(&tier).Error
+	return &tier, err
+}
+
+func (r *wageTierRepository) ListAll(role string) ([]models.WageTier, error) {
+	var tiers []models.WageTier
+	q := config.DB.Model(&models.WageTier{})
+	if role != "" {
+		q = q.Where("role = ?", role)
+	}
+	err := q.Order("role ASC, min_completed_work ASC").Find(&tiers).Error
+	return tiers, err
+}
+
+func (r *wageTierRepository) ListByRoleTx(tx *gorm.DB, role string) ([]models.WageTier, error) {
+	var tiers []models.WageTier
+	err := tx.
+		Where("role = ?", role).
+		Order("min_completed_work DESC").
+		Find(&tiers).Error
+	return tiers, err
+}
+
+// ---------------- WAGE CHANGE LOG ----------------
+
+type wageChangeLogRepository struct{}
+
+func NewWageChangeLogRepository() interfaces.WageChangeLogRepository {
+	return &wageChangeLogRepository{}
+}
+
+func (r *wageChangeLogRepository) CreateTx(tx *gorm.DB, entry *models.WageChangeLog) error {
+	return tx.Create(entry).Error
+}
+
+func (r *wageChangeLogRepository) ListByUser(userID uint, limit int) ([]models.WageChangeLog, error) {
+	var entries []models.WageChangeLog
+	q := config.DB.
+		Where("user_id = ?", userID).
+		Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&entries).Error
+	return entries, err
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type waitlistRepository struct{}
+
+func NewWaitlistRepository() interfaces.WaitlistRepository {
+	return &waitlistRepository{}
+}
+
+func (r *waitlistRepository) CreateTx(tx *gorm.DB, entry *models.Waitlist) error {
+	return tx.Create(entry).Error
+}
+
+func (r *waitlistRepository) FindByEventAndUser(eventID uint, userID uint) (*models.Waitlist, error) {
+	return r.FindByEventAndUserTx(config.DB, eventID, userID)
+}
+
+func (r *waitlistRepository) FindByEventAndUserTx(tx *gorm.DB, eventID uint, userID uint) (*models.Waitlist, error) {
+	var entry models.Waitlist
+	err := tx.
+		Where("event_id = ? AND user_id = ?", eventID, userID).
+		First(&entry).Error
+	return &entry, err
+}
+
+func (r *waitlistRepository) ListByUser(userID uint) ([]models.Waitlist, error) {
+	var entries []models.Waitlist
+	err := config.DB.
+		Preload("Event").
+		Joins("JOIN events ON events.id = waitlists.event_id").
+		Where(`
+			waitlists.user_id = ?
+			AND waitlists.status = ?
+			AND events.status = ?
+			AND events.deleted_at IS NULL
+		`, userID, models.WaitlistStatusWaiting, models.EventStatusUpcoming).
+		Order("waitlists.created_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+// CountAhead returns how many users joined the same role queue earlier.
+func (r *waitlistRepository) CountAhead(entry *models.Waitlist) (int64, error) {
+	var count int64
+	err := config.DB.
+		Model(&models.Waitlist{}).
+		Where(
+			"event_id = ? AND role = ? AND status = ? AND id < ?",
+			entry.EventID, entry.Role, models.WaitlistStatusWaiting, entry.ID,
+		).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *waitlistRepository) Delete(id uint) error {
+	return r.DeleteTx(config.DB, id)
+}
+
+func (r *waitlistRepository) DeleteTx(tx *gorm.DB, id uint) error {
+	res := tx.Delete(&models.Waitlist{}, id)
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return res.Error
+}
+
+// ListWaitingForUpdate locks the waiting queue of a role in join order.
+func (r *waitlistRepository) ListWaitingForUpdate(
+	tx *gorm.DB,
+	eventID uint,
+	role string,
+) ([]models.Waitlist, error) {
+
+	var entries []models.Waitlist
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(
+			"event_id = ? AND role = ? AND status = ?",
+			eventID, role, models.WaitlistStatusWaiting,
+		).
+		Order("created_at ASC, id ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *waitlistRepository) UpdateTx(tx *gorm.DB, entry *models.Waitlist) error {
+	return tx.Save(entry).Error
+}
@@ -5,13 +5,23 @@ import (
 	adminHandlers "event-management-backend/internal/handlers/admin"
 	"event-management-backend/internal/middleware"
 	"event-management-backend/internal/repository"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/advances"
 	"event-management-backend/internal/services/admin"
 	"event-management-backend/internal/services/auth"
+	"event-management-backend/internal/services/export"
+	"event-management-backend/internal/services/loginlimit"
+	"event-management-backend/internal/services/payslip"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/services/waitlist"
 
 	"github.com/gin-gonic/gin"
 )
 
-func AdminRoutes(r *gin.RouterGroup) {
+// loginLimiter is shared with AuthRoutes so admins see and clear the same
+// counters the login endpoint uses.
+func AdminRoutes(r *gin.RouterGroup, loginLimiter *loginlimit.Limiter) {
 	// ---------------- Repositories ----------------
 	userRepo := repository.NewUserRepository()
 	wageRepo := repository.NewRoleWageRepository()
@@ -20,33 +30,76 @@ func AdminRoutes(r *gin.RouterGroup) {
 	bookingRepo := repository.NewBookingRepository()
 	roleRepo := repository.NewRoleRepository()
 	permRepo := repository.NewPermissionRepository()
+	waitlistRepo := repository.NewWaitlistRepository()
+	seriesRepo := repository.NewEventSeriesRepository()
+	templateRepo := repository.NewEventTemplateRepository()
+	lifecycleRepo := repository.NewEventLifecycleLogRepository()
+	payrollRepo := repository.NewPayrollRepository()
+	settingRepo := repository.NewSettingRepository()
+	revisionRepo := repository.NewRoleWageRevisionRepository()
+	tierRepo := repository.NewWageTierRepository()
+	wageLogRepo := repository.NewWageChangeLogRepository()
+	overrideRepo := repository.NewWageOverrideRepository()
+	startingPointRepo := repository.NewStartingPointRepository()
+	taPolicyRepo := repository.NewTAPolicyRepository()
+	adjustmentRuleRepo := repository.NewAdjustmentRuleRepository()
+	bookingAdjustmentRepo := repository.NewBookingAdjustmentRepository()
+	advanceRepo := repository.NewAdvanceRepository()
+	securityRepo := repository.NewSecurityEventRepository()
 
 	// ---------------- Services ----------------
 	jwtService := auth.NewJWTService()
+	tokenVersions := auth.NewTokenVersionCache(userRepo)
+	wageResolver := wages.NewWageResolver(revisionRepo, tierRepo, wageLogRepo, overrideRepo)
+	taCalculator := travel.NewTACalculator(startingPointRepo, taPolicyRepo, settingRepo, eventRepo, bookingRepo, userRepo)
+	adjustmentApplier := adjustments.NewApplier(adjustmentRuleRepo, bookingAdjustmentRepo, settingRepo)
+	advanceLedger := advances.NewLedger(advanceRepo)
 
-	userService := admin.NewAdminUserService(userRepo, wageRepo)
-	eventService := admin.NewAdminEventService(eventRepo)
-	bookingService := admin.NewAdminBookingService(bookingRepo, eventRepo)
-	wageService := admin.NewWageService(bookingRepo, eventRepo)
+	waitlistService := waitlist.NewWaitlistService(waitlistRepo, bookingRepo, eventRepo, userRepo, wageResolver)
+	userService := admin.NewAdminUserService(userRepo, wageRepo, wageResolver, refreshRepo, securityRepo)
+	eventService := admin.NewAdminEventService(eventRepo, waitlistService, lifecycleRepo, wageResolver)
+	seriesService := admin.NewEventSeriesService(seriesRepo, eventRepo, eventService)
+	templateService := admin.NewEventTemplateService(templateRepo, eventService)
+	bookingService := admin.NewAdminBookingService(bookingRepo, eventRepo, waitlistService, wageResolver, taCalculator, adjustmentApplier)
+	wageService := admin.NewWageService(bookingRepo, eventRepo, adjustmentApplier)
+	payrollService := admin.NewPayrollService(payrollRepo, advanceLedger)
+	payslipService := payslip.NewPayslipService(payrollRepo, bookingRepo, eventRepo, userRepo)
 	dashboardService := admin.NewDashboardService()
-	roleWageService := admin.NewRoleWageService(wageRepo, userRepo)
-	roleService := admin.NewRoleService(roleRepo, permRepo)
+	roleWageService := admin.NewRoleWageService(wageRepo, userRepo, revisionRepo, wageResolver)
+	wageTierService := admin.NewWageTierService(tierRepo, wageResolver)
+	wageOverrideService := admin.NewWageOverrideService(overrideRepo, userRepo, wageResolver)
+	travelService := admin.NewTravelAllowanceService(startingPointRepo, taPolicyRepo, taCalculator)
+	adjustmentRuleService := admin.NewAdjustmentRuleService(adjustmentRuleRepo)
+	advanceService := admin.NewAdvanceService(advanceRepo, userRepo)
+	exportService := export.NewExportService(eventRepo, payrollRepo)
+	roleService := admin.NewRoleService(roleRepo, permRepo, userRepo)
 
 	// ---------------- Handlers ----------------
 	userHandler := adminHandlers.NewAdminUserHandler(userService)
 	eventHandler := adminHandlers.NewAdminEventHandler(eventService)
+	seriesHandler := adminHandlers.NewEventSeriesHandler(seriesService)
+	templateHandler := adminHandlers.NewEventTemplateHandler(templateService)
 	bookingHandler := adminHandlers.NewAdminBookingHandler(bookingService)
 	wageHandler := adminHandlers.NewAdminWageHandler(wageService)
+	payrollHandler := adminHandlers.NewPayrollHandler(payrollService)
+	payslipHandler := adminHandlers.NewAdminPayslipHandler(payslipService)
 	dashboardHandler := adminHandlers.NewAdminDashboardHandler(dashboardService)
 	profileHandler := adminHandlers.NewAdminProfileHandler(userService)
 	roleWageHandler := adminHandlers.NewRoleWageHandler(roleWageService)
+	wageTierHandler := adminHandlers.NewWageTierHandler(wageTierService)
+	wageOverrideHandler := adminHandlers.NewWageOverrideHandler(wageOverrideService)
+	travelHandler := adminHandlers.NewTravelAllowanceHandler(travelService)
+	adjustmentRuleHandler := adminHandlers.NewAdjustmentRuleHandler(adjustmentRuleService)
+	advanceHandler := adminHandlers.NewAdvanceHandler(advanceService)
+	exportHandler := adminHandlers.NewExportHandler(exportService)
+	lockoutHandler := adminHandlers.NewLoginLockoutHandler(loginLimiter)
 	roleHandler := adminHandlers.NewAdminRoleHandler(roleService)
 	settingHandler := adminHandlers.NewSettingHandler(config.DB)
 
 	// ---------------- Routes ----------------
 	adminGroup := r.Group("/admin")
 	adminGroup.Use(
-		middleware.JWTAuthMiddleware(jwtService, refreshRepo),
+		middleware.JWTAuthMiddleware(jwtService, tokenVersions),
 		middleware.AdminMiddleware(),
 	)
 
@@ -60,21 +113,38 @@ func AdminRoutes(r *gin.RouterGroup) {
 		users.GET("/",middleware.HasPermission("user:view"), userHandler.ListUsers)
 		users.GET("/role/:role",middleware.HasPermission("user:view"), userHandler.ListUsersByRole)
 		users.GET("/search",middleware.HasPermission("user:view"), userHandler.SearchUsersByPhone)
+		users.GET("/security-events", middleware.HasPermission("user:view"), userHandler.ListSecurityEvents)
 		users.GET("/:id",middleware.HasPermission("user:view"), userHandler.GetUser)
 		users.PUT("/:id",middleware.HasPermission("user:edit"), userHandler.UpdateUser)
 		users.PUT("/block/:id", middleware.HasPermission("user:status"),userHandler.BlockUser)
 		users.PUT("/unblock/:id",middleware.HasPermission("user:status"), userHandler.UnblockUser)
+		users.DELETE("/:id/sessions", middleware.HasPermission("user:status"), userHandler.RevokeSessions)
 		users.DELETE("/:id/photo",middleware.HasPermission("user:edit"), userHandler.RemoveUserPhoto)
 		users.DELETE("/:id",middleware.HasPermission("user:delete"), userHandler.DeleteUser)
 		users.PUT("/reset-password/:id",middleware.HasPermission("user:password"), userHandler.ResetPassword)
+		users.GET("/:id/wage-override", middleware.HasPermission("wage:view"), wageOverrideHandler.GetOverride)
+		users.PUT("/:id/wage-override", middleware.HasPermission("wage:edit"), wageOverrideHandler.SetOverride)
+		users.DELETE("/:id/wage-override", middleware.HasPermission("wage:edit"), wageOverrideHandler.RemoveOverride)
+		users.POST("/:id/advances", middleware.HasPermission("advance:manage"), advanceHandler.IssueAdvance)
+		users.PUT("/:id/bank-details", middleware.HasPermission("user:edit"), userHandler.UpdateBankDetails)
+	}
+
+	// --- LOGIN LOCKOUTS ---
+	lockouts := adminGroup.Group("/login-lockouts")
+	{
+		lockouts.GET("/", middleware.HasPermission("user:status"), lockoutHandler.ListLockouts)
+		lockouts.DELETE("/:kind/:value", middleware.HasPermission("user:status"), lockoutHandler.ClearLockout)
 	}
 
     // --- EVENT MANAGEMENT ---
 	events := adminGroup.Group("/events")
 	{
         events.GET("/", middleware.HasPermission("event:view"), eventHandler.ListEvents)
+		events.GET("/lifecycle-logs", middleware.HasPermission("event:view"), eventHandler.ListLifecycleLogs)
     	events.GET("/:id", middleware.HasPermission("event:view"), eventHandler.GetEvent)
 		events.POST("/", middleware.HasPermission("event:create"), eventHandler.CreateEvent)
+		events.POST("/from-template/:id", middleware.HasPermission("event:create"), templateHandler.CreateEventFromTemplate)
+		events.POST("/:id/clone", middleware.HasPermission("event:create"), eventHandler.CloneEvent)
         events.PUT("/:id", middleware.HasPermission("event:edit"), eventHandler.UpdateEvent)
         events.DELETE("/:id", middleware.HasPermission("event:delete"), eventHandler.DeleteEvent)
         
@@ -84,16 +154,90 @@ func AdminRoutes(r *gin.RouterGroup) {
 		events.PUT("/cancel/:id", middleware.HasPermission("event:operate"), eventHandler.CancelEvent)
 	}
 
+    // --- RECURRING EVENT SERIES ---
+	series := adminGroup.Group("/event-series")
+	{
+		series.GET("/", middleware.HasPermission("event:view"), seriesHandler.ListSeries)
+		series.GET("/:id", middleware.HasPermission("event:view"), seriesHandler.GetSeries)
+		series.POST("/", middleware.HasPermission("event:create"), seriesHandler.CreateSeries)
+		series.PUT("/:id/events/:event_id", middleware.HasPermission("event:edit"), seriesHandler.UpdateOccurrences)
+		series.PUT("/cancel/:id", middleware.HasPermission("event:operate"), seriesHandler.CancelSeries)
+	}
+
+    // --- EVENT TEMPLATES ---
+	templates := adminGroup.Group("/event-templates")
+	{
+		templates.GET("/", middleware.HasPermission("template:view"), templateHandler.ListTemplates)
+		templates.GET("/:id", middleware.HasPermission("template:view"), templateHandler.GetTemplate)
+		templates.POST("/", middleware.HasPermission("template:create"), templateHandler.CreateTemplate)
+		templates.PUT("/:id", middleware.HasPermission("template:edit"), templateHandler.UpdateTemplate)
+		templates.DELETE("/:id", middleware.HasPermission("template:delete"), templateHandler.DeleteTemplate)
+	}
+
    // --- BOOKINGS & WAGES ---
 	adminGroup.GET("/events/bookings/:event_id", middleware.HasPermission("event:view"), bookingHandler.ListEventBookings)
 	adminGroup.DELETE("/events/bookings/:event_id/:booking_id", middleware.HasPermission("event:operate"), bookingHandler.RemoveUserFromEvent)
+	adminGroup.GET("/bookings/cancellations", middleware.HasPermission("event:view"), bookingHandler.ListCancellations)
 	adminGroup.PUT("/bookings/:booking_id/attendance", middleware.HasPermission("event:operate"), bookingHandler.UpdateAttendance)
 	adminGroup.PUT("/bookings/:booking_id/wage", middleware.HasPermission("wage:edit"), wageHandler.OverrideWage)
+	adminGroup.GET("/adjustments/pending", middleware.HasPermission("wage:edit"), wageHandler.ListPendingAdjustments)
+	adminGroup.PUT("/adjustments/:id/approve", middleware.HasPermission("wage:edit"), wageHandler.ApproveAdjustment)
+	adminGroup.PUT("/adjustments/:id/reject", middleware.HasPermission("wage:edit"), wageHandler.RejectAdjustment)
 	adminGroup.GET("/events/bookings/:event_id/status/:status",middleware.HasPermission("event:view"), bookingHandler.ListEventBookingsByStatus)
 	adminGroup.GET("/events/bookings/:event_id/search",middleware.HasPermission("event:view"), bookingHandler.SearchEventBookingsByName)
 	adminGroup.GET("/reports/events/:event_id/wages/summary", middleware.HasPermission("wage:view"), bookingHandler.GetEventWageSummary)
+	adminGroup.GET("/reports/events/:event_id/wages/export", middleware.HasPermission("wage:view"), exportHandler.ExportEventWages)
+	adminGroup.GET("/reports/wages/export", middleware.HasPermission("wage:view"), exportHandler.ExportRangeWages)
+	adminGroup.GET("/events/bookings/:event_id/ta-proposals", middleware.HasPermission("event:view"), travelHandler.ListProposals)
+	adminGroup.GET("/reports/check-ins/rejected", middleware.HasPermission("event:view"), bookingHandler.ListRejectedCheckIns)
+
+    // --- TRAVEL ALLOWANCE ---
+	ta := adminGroup.Group("/ta")
+	{
+		ta.GET("/starting-points", middleware.HasPermission("ta:manage"), travelHandler.ListStartingPoints)
+		ta.POST("/starting-points", middleware.HasPermission("ta:manage"), travelHandler.CreateStartingPoint)
+		ta.PUT("/starting-points/:id", middleware.HasPermission("ta:manage"), travelHandler.UpdateStartingPoint)
+		ta.DELETE("/starting-points/:id", middleware.HasPermission("ta:manage"), travelHandler.DeleteStartingPoint)
+		ta.GET("/policies", middleware.HasPermission("ta:manage"), travelHandler.ListPolicies)
+		ta.POST("/policies", middleware.HasPermission("ta:manage"), travelHandler.CreatePolicy)
+		ta.PUT("/policies/:id", middleware.HasPermission("ta:manage"), travelHandler.UpdatePolicy)
+		ta.DELETE("/policies/:id", middleware.HasPermission("ta:manage"), travelHandler.DeletePolicy)
+	}
+
+    // --- FINES & BONUSES ---
+	adjustmentRules := adminGroup.Group("/adjustment-rules")
+	{
+		adjustmentRules.GET("", middleware.HasPermission("adjustment:manage"), adjustmentRuleHandler.ListRules)
+		adjustmentRules.POST("", middleware.HasPermission("adjustment:manage"), adjustmentRuleHandler.CreateRule)
+		adjustmentRules.PUT("/:id", middleware.HasPermission("adjustment:manage"), adjustmentRuleHandler.UpdateRule)
+		adjustmentRules.DELETE("/:id", middleware.HasPermission("adjustment:manage"), adjustmentRuleHandler.DeleteRule)
+	}
 	
 	
+    // --- ADVANCES ---
+	advanceGroup := adminGroup.Group("/advances")
+	{
+		advanceGroup.GET("", middleware.HasPermission("advance:view"), advanceHandler.ListAdvances)
+		advanceGroup.GET("/:id", middleware.HasPermission("advance:view"), advanceHandler.GetAdvance)
+		advanceGroup.PUT("/:id", middleware.HasPermission("advance:manage"), advanceHandler.UpdateSchedule)
+		advanceGroup.POST("/:id/repayments", middleware.HasPermission("advance:manage"), advanceHandler.RecordRepayment)
+		advanceGroup.PUT("/:id/write-off", middleware.HasPermission("advance:manage"), advanceHandler.WriteOff)
+	}
+
+    // --- PAYROLL ---
+	payroll := adminGroup.Group("/payroll")
+	{
+		payroll.GET("/periods", middleware.HasPermission("payroll:view"), payrollHandler.ListPeriods)
+		payroll.GET("/periods/:id", middleware.HasPermission("payroll:view"), payrollHandler.GetPeriod)
+		payroll.GET("/periods/:id/payouts", middleware.HasPermission("payroll:view"), payrollHandler.ListPayouts)
+		payroll.GET("/periods/:id/payslips", middleware.HasPermission("payroll:view"), payslipHandler.DownloadPeriodPayslips)
+		payroll.GET("/periods/:id/bank-file", middleware.HasPermission("payroll:manage"), exportHandler.ExportBankFile)
+		payroll.POST("/periods", middleware.HasPermission("payroll:manage"), payrollHandler.CreatePeriod)
+		payroll.PUT("/periods/:id/close", middleware.HasPermission("payroll:manage"), payrollHandler.ClosePeriod)
+		payroll.GET("/payouts/:id", middleware.HasPermission("payroll:view"), payrollHandler.GetPayout)
+		payroll.PUT("/payouts/:id/pay", middleware.HasPermission("payroll:manage"), payrollHandler.MarkPaid)
+	}
+
     // --- DASHBOARD & PROFILE ---
 	adminGroup.GET("/dashboard/summary", middleware.HasPermission("dashboard:view"), dashboardHandler.GetSummary)
 	adminGroup.GET("/dashboard/charts/monthly", middleware.HasPermission("dashboard:view"), dashboardHandler.GetMonthlyChart)
@@ -103,6 +247,13 @@ func AdminRoutes(r *gin.RouterGroup) {
     // --- ROLE WAGES ---
 	adminGroup.GET("/wages", middleware.HasPermission("managewages:view"), roleWageHandler.List)
 	adminGroup.PUT("/wages/:role", middleware.HasPermission("managewages:view"), roleWageHandler.Update)
+	adminGroup.GET("/wages/:role/revisions", middleware.HasPermission("managewages:view"), roleWageHandler.ListRevisions)
+	adminGroup.POST("/wages/:role/revisions", middleware.HasPermission("managewages:view"), roleWageHandler.ScheduleChange)
+	adminGroup.DELETE("/wages/revisions/:id", middleware.HasPermission("managewages:view"), roleWageHandler.CancelRevision)
+	adminGroup.GET("/wages/tiers", middleware.HasPermission("managewages:view"), wageTierHandler.ListTiers)
+	adminGroup.POST("/wages/tiers", middleware.HasPermission("managewages:view"), wageTierHandler.CreateTier)
+	adminGroup.PUT("/wages/tiers/:id", middleware.HasPermission("managewages:view"), wageTierHandler.UpdateTier)
+	adminGroup.DELETE("/wages/tiers/:id", middleware.HasPermission("managewages:view"), wageTierHandler.DeleteTier)
 
     // --- RBAC MANAGEMENT ---
     rbac := adminGroup.Group("/rbac")
@@ -5,21 +5,48 @@ import (
 	"event-management-backend/internal/handlers"
 	"event-management-backend/internal/middleware"
 	"event-management-backend/internal/services/auth"
+	"event-management-backend/internal/services/loginlimit"
+	"event-management-backend/internal/services/notify"
+	"event-management-backend/internal/services/sms"
 
 	"github.com/gin-gonic/gin"
 )
 
-func AuthRoutes(r *gin.RouterGroup, userRepo interfaces.UserRepository, refreshRepo interfaces.RefreshTokenRepository) {
+func AuthRoutes(
+	r *gin.RouterGroup,
+	userRepo interfaces.UserRepository,
+	refreshRepo interfaces.RefreshTokenRepository,
+	wageLogRepo interfaces.WageChangeLogRepository,
+	securityRepo interfaces.SecurityEventRepository,
+	otpRepo interfaces.OTPRepository,
+	resetRepo interfaces.PasswordResetRepository,
+	requestRepo interfaces.AuthRequestRepository,
+	smsSender sms.SMSSender,
+	loginLimiter *loginlimit.Limiter,
+) {
 	jwtService := auth.NewJWTService()
-	authHandler := handlers.NewAuthHandler(userRepo, refreshRepo, jwtService)
+	tokenVersions := auth.NewTokenVersionCache(userRepo)
+	sessionService := auth.NewSessionService(refreshRepo, securityRepo, jwtService)
+	otpService := auth.NewOTPService(userRepo, otpRepo, requestRepo, smsSender)
+	passwordService := auth.NewPasswordService(userRepo, refreshRepo, resetRepo, requestRepo, notify.NewSMSNotifier(smsSender))
+	authHandler := handlers.NewAuthHandler(userRepo, refreshRepo, wageLogRepo, jwtService, sessionService, otpService, passwordService, loginLimiter)
 
 	r.POST("/auth/login", authHandler.Login)
+	r.POST("/auth/refresh", authHandler.Refresh)
+	r.POST("/auth/otp/request", authHandler.RequestOTP)
+	r.POST("/auth/otp/verify", authHandler.VerifyOTP)
+	r.POST("/auth/password/forgot", authHandler.ForgotPassword)
+	r.POST("/auth/password/reset", authHandler.ResetPassword)
     // r.POST("/auth/worker/login", authHandler.WorkerLogin)
     // r.POST("/auth/admin/login", authHandler.AdminLogin)
 
 	auth := r.Group("/auth")
-	auth.Use(middleware.JWTAuthMiddleware(jwtService, refreshRepo))
+	auth.Use(middleware.JWTAuthMiddleware(jwtService, tokenVersions))
 
 	auth.POST("/logout", authHandler.Logout)
+	auth.POST("/logout-all", authHandler.LogoutAll)
+	auth.PUT("/password", authHandler.ChangePassword)
+	auth.GET("/sessions", authHandler.ListSessions)
+	auth.DELETE("/sessions/:id", authHandler.RevokeSession)
 	auth.GET("/profile", authHandler.Profile)
 }
\ No newline at end of file
@@ -4,32 +4,59 @@ import (
 	captainHandlers "event-management-backend/internal/handlers/captain"
 	"event-management-backend/internal/middleware"
 	"event-management-backend/internal/repository"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/attendance"
 	"event-management-backend/internal/services/auth"
+	"event-management-backend/internal/services/earnings"
 	"event-management-backend/internal/services/captain"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/services/waitlist"
 
 	"github.com/gin-gonic/gin"
 )
 
 func CaptainRoutes(r *gin.RouterGroup) {
 	// ---------------- Repositories ----------------
-	refreshRepo := repository.NewRefreshTokenRepository()
 	eventRepo := repository.NewEventRepository()
 	bookingRepo := repository.NewBookingRepository()
 	userRepo := repository.NewUserRepository()
+	cancellationRepo := repository.NewBookingCancellationRepository()
+	settingRepo := repository.NewSettingRepository()
+	waitlistRepo := repository.NewWaitlistRepository()
+	attemptRepo := repository.NewCheckInAttemptRepository()
+	revisionRepo := repository.NewRoleWageRevisionRepository()
+	tierRepo := repository.NewWageTierRepository()
+	wageLogRepo := repository.NewWageChangeLogRepository()
+	overrideRepo := repository.NewWageOverrideRepository()
+	startingPointRepo := repository.NewStartingPointRepository()
+	taPolicyRepo := repository.NewTAPolicyRepository()
+	adjustmentRuleRepo := repository.NewAdjustmentRuleRepository()
+	bookingAdjustmentRepo := repository.NewBookingAdjustmentRepository()
 
 	// ---------------- Services ----------------
 	jwtService := auth.NewJWTService()
-	eventService := captain.NewCaptainEventService(eventRepo)
-	bookingService := captain.NewCaptainBookingService(bookingRepo, eventRepo, userRepo)
+	tokenVersions := auth.NewTokenVersionCache(userRepo)
+	earningsService := earnings.NewEarningsService()
+	wageResolver := wages.NewWageResolver(revisionRepo, tierRepo, wageLogRepo, overrideRepo)
+	taCalculator := travel.NewTACalculator(startingPointRepo, taPolicyRepo, settingRepo, eventRepo, bookingRepo, userRepo)
+	adjustmentApplier := adjustments.NewApplier(adjustmentRuleRepo, bookingAdjustmentRepo, settingRepo)
+	eventService := captain.NewCaptainEventService(eventRepo, wageResolver)
+	waitlistService := waitlist.NewWaitlistService(waitlistRepo, bookingRepo, eventRepo, userRepo, wageResolver)
+	attendanceService := attendance.NewAttendanceService(bookingRepo, eventRepo, settingRepo, attemptRepo, wageResolver, taCalculator)
+	bookingService := captain.NewCaptainBookingService(bookingRepo, eventRepo, userRepo, cancellationRepo, settingRepo, waitlistService, wageResolver, taCalculator, adjustmentApplier)
 
 	// ---------------- Handlers ----------------
 	eventHandler := captainHandlers.NewCaptainEventHandler(eventService)
 	bookingHandler := captainHandlers.NewCaptainBookingHandler(bookingService)
+	attendanceHandler := captainHandlers.NewCaptainAttendanceHandler(attendanceService)
+	waitlistHandler := captainHandlers.NewCaptainWaitlistHandler(waitlistService)
+	earningsHandler := captainHandlers.NewCaptainEarningsHandler(earningsService)
 
 	// ---------------- Routes ----------------
 	captainGroup := r.Group("/captain")
 	captainGroup.Use(
-		middleware.JWTAuthMiddleware(jwtService, refreshRepo),
+		middleware.JWTAuthMiddleware(jwtService, tokenVersions),
 		middleware.CaptainMiddleware(),
 	)
 
@@ -42,15 +69,26 @@ func CaptainRoutes(r *gin.RouterGroup) {
 	// BOOK EVENT
 	captainGroup.POST("/events/:event_id/book", bookingHandler.BookEvent)
 
+	// WAITLIST
+	captainGroup.POST("/events/:event_id/waitlist", waitlistHandler.JoinWaitlist)
+	captainGroup.DELETE("/events/:event_id/waitlist", waitlistHandler.LeaveWaitlist)
+	captainGroup.GET("/waitlist", waitlistHandler.ListMyWaitlist)
+
 	// BOOKING LISTS
 	captainGroup.GET("/bookings/today", bookingHandler.ListTodayBookings)
 	captainGroup.GET("/bookings/upcoming", bookingHandler.ListUpcomingBookings)
 	captainGroup.GET("/bookings/completed", bookingHandler.ListCompletedBookings)
+	captainGroup.DELETE("/bookings/:booking_id", bookingHandler.CancelBooking)
+	captainGroup.GET("/bookings/:booking_id/check-in-qr", attendanceHandler.GetCheckInQR)
 
 	// ATTENDANCE
 	captainGroup.GET("/event-attendance/:event_id", bookingHandler.ListEventBookings)
 	captainGroup.PUT("/event-attendance/:event_id", bookingHandler.UpdateAttendance)
+	captainGroup.POST("/attendance/scan", attendanceHandler.Scan)
 	captainGroup.GET("/event-attendance/:event_id/status/:status", bookingHandler.ListEventBookingsByStatus)
 	captainGroup.GET("/event-attendance/:event_id/search", bookingHandler.SearchEventBookingsByName)
+	captainGroup.GET("/event-attendance/:event_id/ta-proposals", bookingHandler.ListTAProposals)
+	captainGroup.GET("/adjustment-rules", bookingHandler.ListAdjustmentRules)
+	captainGroup.GET("/earnings", earningsHandler.GetEarnings)
 	captainGroup.GET("/reports/events/:event_id/wages/summary",bookingHandler.GetEventWageSummary)
 }
\ No newline at end of file
@@ -4,7 +4,15 @@ import (
 	workerHandlers "event-management-backend/internal/handlers/worker"
 	"event-management-backend/internal/middleware"
 	"event-management-backend/internal/repository"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/advances"
+	"event-management-backend/internal/services/attendance"
 	"event-management-backend/internal/services/auth"
+	"event-management-backend/internal/services/earnings"
+	"event-management-backend/internal/services/payslip"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/services/waitlist"
 	"event-management-backend/internal/services/worker"
 
 	"github.com/gin-gonic/gin"
@@ -12,28 +20,60 @@ import (
 
 func WorkerRoutes(r *gin.RouterGroup) {
 	// ---------------- Repositories ----------------
-	refreshRepo := repository.NewRefreshTokenRepository()
 	eventRepo := repository.NewEventRepository()
 	bookingRepo := repository.NewBookingRepository()
 	userRepo := repository.NewUserRepository()
+	cancellationRepo := repository.NewBookingCancellationRepository()
+	settingRepo := repository.NewSettingRepository()
+	waitlistRepo := repository.NewWaitlistRepository()
+	attemptRepo := repository.NewCheckInAttemptRepository()
+	revisionRepo := repository.NewRoleWageRevisionRepository()
+	tierRepo := repository.NewWageTierRepository()
+	wageLogRepo := repository.NewWageChangeLogRepository()
+	overrideRepo := repository.NewWageOverrideRepository()
+	startingPointRepo := repository.NewStartingPointRepository()
+	taPolicyRepo := repository.NewTAPolicyRepository()
+	payrollRepo := repository.NewPayrollRepository()
+	adjustmentRuleRepo := repository.NewAdjustmentRuleRepository()
+	bookingAdjustmentRepo := repository.NewBookingAdjustmentRepository()
+	advanceRepo := repository.NewAdvanceRepository()
 
 	// ---------------- Services ----------------
 	jwtService := auth.NewJWTService()
+	tokenVersions := auth.NewTokenVersionCache(userRepo)
+	earningsService := earnings.NewEarningsService()
+	wageResolver := wages.NewWageResolver(revisionRepo, tierRepo, wageLogRepo, overrideRepo)
+	taCalculator := travel.NewTACalculator(startingPointRepo, taPolicyRepo, settingRepo, eventRepo, bookingRepo, userRepo)
+	adjustmentApplier := adjustments.NewApplier(adjustmentRuleRepo, bookingAdjustmentRepo, settingRepo)
+	advanceLedger := advances.NewLedger(advanceRepo)
 	eventService := worker.NewWorkerEventService(eventRepo)
+	waitlistService := waitlist.NewWaitlistService(waitlistRepo, bookingRepo, eventRepo, userRepo, wageResolver)
+	payslipService := payslip.NewPayslipService(payrollRepo, bookingRepo, eventRepo, userRepo)
+	attendanceService := attendance.NewAttendanceService(bookingRepo, eventRepo, settingRepo, attemptRepo, wageResolver, taCalculator)
 	bookingService := worker.NewWorkerBookingService(
 		bookingRepo,
 		eventRepo,
 		userRepo,
+		cancellationRepo,
+		settingRepo,
+		waitlistService,
+		wageResolver,
+		adjustmentApplier,
 	)
 
 	// ---------------- Handlers ----------------
 	eventHandler := workerHandlers.NewWorkerEventHandler(eventService)
 	bookingHandler := workerHandlers.NewWorkerBookingHandler(bookingService)
+	payslipHandler := workerHandlers.NewWorkerPayslipHandler(payslipService)
+	attendanceHandler := workerHandlers.NewWorkerAttendanceHandler(attendanceService)
+	waitlistHandler := workerHandlers.NewWorkerWaitlistHandler(waitlistService)
+	earningsHandler := workerHandlers.NewWorkerEarningsHandler(earningsService)
+	advanceHandler := workerHandlers.NewWorkerAdvanceHandler(advanceLedger)
 
 	// ---------------- Routes ----------------
 	workerGroup := r.Group("/worker")
 	workerGroup.Use(
-		middleware.JWTAuthMiddleware(jwtService, refreshRepo),
+		middleware.JWTAuthMiddleware(jwtService, tokenVersions),
 		middleware.WorkerMiddleware(), // sub_captain, main_boy, junior_boy
 	)
 
@@ -44,10 +84,28 @@ func WorkerRoutes(r *gin.RouterGroup) {
 	// BOOK EVENT
 	workerGroup.POST("/events/:event_id/book", bookingHandler.BookEvent)
 
+	// WAITLIST
+	workerGroup.POST("/events/:event_id/waitlist", waitlistHandler.JoinWaitlist)
+	workerGroup.DELETE("/events/:event_id/waitlist", waitlistHandler.LeaveWaitlist)
+	workerGroup.GET("/waitlist", waitlistHandler.ListMyWaitlist)
+
 	// BOOKINGS
 	workerGroup.GET("/bookings", bookingHandler.ListMyBookings)
 	workerGroup.GET("/bookings/:booking_id", bookingHandler.GetBookingDetails)
+	workerGroup.DELETE("/bookings/:booking_id", bookingHandler.CancelBooking)
+	workerGroup.GET("/bookings/:booking_id/check-in-qr", attendanceHandler.GetCheckInQR)
+	workerGroup.POST("/bookings/:booking_id/check-in", attendanceHandler.SelfCheckIn)
 
 	// COMPLETED
 	workerGroup.GET("/bookings/completed", bookingHandler.ListCompletedBookings)
+
+	// PAYSLIPS
+	workerGroup.GET("/payslips/:period", payslipHandler.GetPeriodPayslip)
+	workerGroup.GET("/payslips/events/:event_id", payslipHandler.GetEventPayslip)
+
+	// EARNINGS
+	workerGroup.GET("/earnings", earningsHandler.GetEarnings)
+
+	// ADVANCES
+	workerGroup.GET("/advances", advanceHandler.GetMyAdvances)
 }
\ No newline at end of file
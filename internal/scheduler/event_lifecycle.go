@@ -0,0 +1,178 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+)
+
+const (
+	defaultIntervalMinutes   = 5
+	defaultOngoingGraceHours = 12
+)
+
+// EventLifecycleScheduler moves events along upcoming -> ongoing -> completed
+// without an admin or captain, and cancels past events nobody booked.
+// Every run reads its settings again, so changes apply on the next tick.
+type EventLifecycleScheduler struct {
+	eventRepo    interfaces.EventRepository
+	bookingRepo  interfaces.BookingRepository
+	settingRepo  interfaces.SettingRepository
+	logRepo      interfaces.EventLifecycleLogRepository
+	eventService *admin.AdminEventService
+}
+
+func NewEventLifecycleScheduler(
+	eventRepo interfaces.EventRepository,
+	bookingRepo interfaces.BookingRepository,
+	settingRepo interfaces.SettingRepository,
+	logRepo interfaces.EventLifecycleLogRepository,
+	eventService *admin.AdminEventService,
+) *EventLifecycleScheduler {
+	return &EventLifecycleScheduler{
+		eventRepo:    eventRepo,
+		bookingRepo:  bookingRepo,
+		settingRepo:  settingRepo,
+		logRepo:      logRepo,
+		eventService: eventService,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled.
+func (s *EventLifecycleScheduler) Start(ctx context.Context) {
+	go func() {
+		for {
+			if s.settingRepo.GetBool(models.SettingSchedulerEnabled, true) {
+				s.RunOnce(time.Now())
+			}
+
+			minutes := s.settingRepo.GetInt(models.SettingSchedulerIntervalMinutes, defaultIntervalMinutes)
+			if minutes <= 0 {
+				minutes = defaultIntervalMinutes
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(minutes) * time.Minute):
+			}
+		}
+	}()
+}
+
+// RunOnce performs a single pass over upcoming and ongoing events.
+func (s *EventLifecycleScheduler) RunOnce(now time.Time) {
+	s.processUpcoming(now)
+	s.processOngoing(now)
+}
+
+// ---------------- UPCOMING ----------------
+
+func (s *EventLifecycleScheduler) processUpcoming(now time.Time) {
+	autoStart := s.settingRepo.GetBool(models.SettingAutoStartEvents, true)
+	autoCancel := s.settingRepo.GetBool(models.SettingAutoCancelEmptyEvents, true)
+	if !autoStart && !autoCancel {
+		return
+	}
+
+	events, err := s.eventRepo.ListAll(models.EventStatusUpcoming, "")
+	if err != nil {
+		log.Printf("scheduler: failed to list upcoming events: %v", err)
+		return
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	for _, event := range events {
+		if event.ReportingAt().After(now) {
+			continue
+		}
+
+		bookings, err := s.bookingRepo.ListByEvent(event.ID)
+		if err != nil {
+			log.Printf("scheduler: failed to load bookings for event %d: %v", event.ID, err)
+			continue
+		}
+
+		// nobody booked: leave it for the day, cancel once the date has passed
+		if len(bookings) == 0 {
+			if autoCancel && event.ReportingAt().Before(today) {
+				s.transition(event, models.LifecycleActionAutoCancel, models.EventStatusCancelled,
+					"event date passed without any bookings", s.eventService.CancelEvent)
+			}
+			continue
+		}
+
+		if autoStart {
+			s.transition(event, models.LifecycleActionAutoStart, models.EventStatusOngoing,
+				"reporting time reached", s.eventService.StartEvent)
+		}
+	}
+}
+
+// ---------------- ONGOING ----------------
+
+func (s *EventLifecycleScheduler) processOngoing(now time.Time) {
+	grace := s.settingRepo.GetInt(models.SettingOngoingGraceHours, defaultOngoingGraceHours)
+	autoComplete := s.settingRepo.GetBool(models.SettingAutoCompleteEvents, false)
+
+	events, err := s.eventRepo.ListAll(models.EventStatusOngoing, "")
+	if err != nil {
+		log.Printf("scheduler: failed to list ongoing events: %v", err)
+		return
+	}
+
+	for _, event := range events {
+		deadline := event.ReportingAt().Add(time.Duration(grace) * time.Hour)
+		if deadline.After(now) {
+			continue
+		}
+
+		reason := fmt.Sprintf("still ongoing %d hours after reporting time", grace)
+
+		if autoComplete {
+			s.transition(event, models.LifecycleActionAutoComplete, models.EventStatusCompleted,
+				reason, s.eventService.CompleteEvent)
+			continue
+		}
+
+		// flag only once per event
+		flagged, err := s.logRepo.Exists(event.ID, models.LifecycleActionOverdue)
+		if err != nil || flagged {
+			continue
+		}
+		s.record(event, models.LifecycleActionOverdue, event.Status, reason)
+	}
+}
+
+// ---------------- HELPERS ----------------
+
+func (s *EventLifecycleScheduler) transition(
+	event models.Event,
+	action, to, reason string,
+	apply func(id uint) error,
+) {
+	if err := apply(event.ID); err != nil {
+		log.Printf("scheduler: %s failed for event %d: %v", action, event.ID, err)
+		return
+	}
+	s.record(event, action, to, reason)
+}
+
+func (s *EventLifecycleScheduler) record(event models.Event, action, to, reason string) {
+	entry := &models.EventLifecycleLog{
+		EventID:    event.ID,
+		Action:     action,
+		FromStatus: event.Status,
+		ToStatus:   to,
+		Reason:     reason,
+	}
+	if err := s.logRepo.Create(entry); err != nil {
+		log.Printf("scheduler: failed to record %s for event %d: %v", action, event.ID, err)
+	}
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/admin"
+)
+
+const roleWageInterval = time.Hour
+
+// RoleWageScheduler applies scheduled role wage revisions once their
+// effective date arrives and releases expired wage overrides.
+type RoleWageScheduler struct {
+	settingRepo     interfaces.SettingRepository
+	roleWageService *admin.RoleWageService
+	overrideService *admin.WageOverrideService
+}
+
+func NewRoleWageScheduler(
+	settingRepo interfaces.SettingRepository,
+	roleWageService *admin.RoleWageService,
+	overrideService *admin.WageOverrideService,
+) *RoleWageScheduler {
+	return &RoleWageScheduler{
+		settingRepo:     settingRepo,
+		roleWageService: roleWageService,
+		overrideService: overrideService,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled.
+func (s *RoleWageScheduler) Start(ctx context.Context) {
+	go func() {
+		for {
+			if s.settingRepo.GetBool(models.SettingSchedulerEnabled, true) {
+				s.RunOnce(time.Now())
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(roleWageInterval):
+			}
+		}
+	}()
+}
+
+// RunOnce applies every revision effective on or before now and releases
+// overrides that expired by now.
+func (s *RoleWageScheduler) RunOnce(now time.Time) {
+	applied, err := s.roleWageService.ApplyDueRevisions(now)
+	if err != nil {
+		log.Printf("scheduler: failed to apply role wage revisions: %v", err)
+	}
+	if applied > 0 {
+		log.Printf("scheduler: applied %d role wage revision(s)", applied)
+	}
+
+	released, err := s.overrideService.ReleaseExpired(now)
+	if err != nil {
+		log.Printf("scheduler: failed to release expired wage overrides: %v", err)
+	}
+	if released > 0 {
+		log.Printf("scheduler: released %d expired wage override(s)", released)
+	}
+}
@@ -0,0 +1,76 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/auth"
+)
+
+const (
+	sessionCleanupInterval = 6 * time.Hour
+	// auth requests only matter to the hourly limits
+	authRequestRetention = 24 * time.Hour
+)
+
+// SessionCleanupScheduler removes refresh tokens that can no longer be used
+// and old OTP and password reset requests. Refresh tokens are kept for one
+// more RefreshTTL after they stop working so reuse of a retired token is
+// still recognised for a while.
+type SessionCleanupScheduler struct {
+	settingRepo interfaces.SettingRepository
+	refreshRepo interfaces.RefreshTokenRepository
+	requestRepo interfaces.AuthRequestRepository
+}
+
+func NewSessionCleanupScheduler(
+	settingRepo interfaces.SettingRepository,
+	refreshRepo interfaces.RefreshTokenRepository,
+	requestRepo interfaces.AuthRequestRepository,
+) *SessionCleanupScheduler {
+	return &SessionCleanupScheduler{
+		settingRepo: settingRepo,
+		refreshRepo: refreshRepo,
+		requestRepo: requestRepo,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is cancelled.
+func (s *SessionCleanupScheduler) Start(ctx context.Context) {
+	go func() {
+		for {
+			if s.settingRepo.GetBool(models.SettingSchedulerEnabled, true) {
+				s.RunOnce(time.Now())
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(sessionCleanupInterval):
+			}
+		}
+	}()
+}
+
+// RunOnce purges refresh tokens that expired or were revoked more than
+// RefreshTTL before now, and auth requests past their retention.
+func (s *SessionCleanupScheduler) RunOnce(now time.Time) {
+	purged, err := s.refreshRepo.PurgeStale(now.UTC().Add(-auth.RefreshTTL))
+	if err != nil {
+		log.Printf("scheduler: failed to purge refresh tokens: %v", err)
+	}
+	if purged > 0 {
+		log.Printf("scheduler: purged %d stale refresh token(s)", purged)
+	}
+
+	purged, err = s.requestRepo.PurgeBefore(now.UTC().Add(-authRequestRetention))
+	if err != nil {
+		log.Printf("scheduler: failed to purge auth requests: %v", err)
+	}
+	if purged > 0 {
+		log.Printf("scheduler: purged %d old auth request(s)", purged)
+	}
+}
@@ -29,10 +29,22 @@ func SeedRBAC(db *gorm.DB) {
 		{Slug: "event:delete", Description: "Delete event entries"},
 		{Slug: "event:operate", Description: "Operational access: Start, Complete, Cancel events and Attendance"},
 
+		// --- EVENT TEMPLATES ---
+		{Slug: "template:view", Description: "View event templates"},
+		{Slug: "template:create", Description: "Create new event templates"},
+		{Slug: "template:edit", Description: "Update existing event templates"},
+		{Slug: "template:delete", Description: "Delete event templates"},
+
 		// --- WAGES & FINANCE ---
 		{Slug: "managewages:view", Description: "Update global standard role-based wages"},
 		{Slug: "wage:view", Description: "View event-specific wage summaries and reports"},
 		{Slug: "wage:edit", Description: "Override individual worker wages for specific bookings"},
+		{Slug: "payroll:view", Description: "View payroll periods and payouts"},
+		{Slug: "payroll:manage", Description: "Create and close payroll periods and mark payouts as paid"},
+		{Slug: "advance:view", Description: "View worker advances and their repayment ledger"},
+		{Slug: "advance:manage", Description: "Issue advances, change repayment schedules and record repayments"},
+		{Slug: "ta:manage", Description: "Manage starting points and travel allowance policies"},
+		{Slug: "adjustment:manage", Description: "Manage the catalogue of fine and bonus rules"},
 
 		// --- DASHBOARD & PROFILE ---
 		{Slug: "dashboard:view", Description: "Access to view dashboard statistics and charts"},
@@ -1,6 +1,8 @@
 package seeders
 
 import (
+	"time"
+
 	"event-management-backend/internal/domain/models"
 
 	"gorm.io/gorm"
@@ -29,4 +31,39 @@ func SeedRoleWages(db *gorm.DB) {
 			panic(err)
 		}
 	}
-}
\ No newline at end of file
+
+	seedRoleWageRevisions(db)
+}
+
+// seedRoleWageRevisions gives every role without history a baseline
+// revision, so bookings on older events keep resolving to today's wage.
+func seedRoleWageRevisions(db *gorm.DB) {
+	var roleWages []models.RoleWage
+	db.Find(&roleWages)
+
+	baseline := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, w := range roleWages {
+		var count int64
+
+		db.Model(&models.RoleWageRevision{}).
+			Where("role = ?", w.Role).
+			Count(&count)
+
+		if count > 0 {
+			continue
+		}
+
+		now := time.Now()
+		revision := models.RoleWageRevision{
+			Role:          w.Role,
+			Wage:          w.Wage,
+			EffectiveFrom: baseline,
+			Applied:       true,
+			AppliedAt:     &now,
+		}
+		if err := db.Create(&revision).Error; err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package adjustments
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// RuleTotal is one line of the per-rule breakdown of an event's wages.
+type RuleTotal struct {
+	RuleID *uint  `json:"rule_id"`
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Count  int64  `json:"count"`
+	Total  int64  `json:"total"`
+}
+
+// Pending sums the lines of a booking still awaiting approval.
+type Pending struct {
+	TA    int64 `json:"ta"`
+	Bonus int64 `json:"bonus"`
+	Fine  int64 `json:"fine"`
+}
+
+// QueueItem is one pending line in the admin approval queue.
+type QueueItem struct {
+	ID        uint      `json:"id"`
+	BookingID uint      `json:"booking_id"`
+	EventID   uint      `json:"event_id"`
+	EventName string    `json:"event_name"`
+	EventDate time.Time `json:"event_date"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Role      string    `json:"role"`
+	RuleID    *uint     `json:"rule_id"`
+	Name      string    `json:"name"`
+	Kind      string    `json:"kind"`
+	Amount    int64     `json:"amount"`
+	CreatedBy *uint     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Applier keeps the fine and bonus lines of bookings and derives
+// BonusAmount and FineAmount from them. Callers save the booking.
+type Applier struct {
+	ruleRepo    interfaces.AdjustmentRuleRepository
+	itemRepo    interfaces.BookingAdjustmentRepository
+	settingRepo interfaces.SettingRepository
+}
+
+func NewApplier(
+	ruleRepo interfaces.AdjustmentRuleRepository,
+	itemRepo interfaces.BookingAdjustmentRepository,
+	settingRepo interfaces.SettingRepository,
+) *Applier {
+	return &Applier{
+		ruleRepo:    ruleRepo,
+		itemRepo:    itemRepo,
+		settingRepo: settingRepo,
+	}
+}
+
+// ReplaceRulesTx replaces the rule lines of the booking. A rule listed twice
+// is applied twice. With review set, the bonus or fine lines whose total is
+// above the approval threshold of the booking role are saved as pending.
+func (a *Applier) ReplaceRulesTx(tx *gorm.DB, booking *models.Booking, ruleIDs []uint, by *uint, review bool) error {
+	items := make([]models.BookingAdjustment, 0, len(ruleIDs))
+
+	if len(ruleIDs) > 0 {
+		rules, err := a.ruleRepo.FindActiveByIDsTx(tx, ruleIDs)
+		if err != nil {
+			return err
+		}
+		byID := make(map[uint]models.AdjustmentRule, len(rules))
+		for _, r := range rules {
+			byID[r.ID] = r
+		}
+
+		for _, id := range ruleIDs {
+			rule, ok := byID[id]
+			if !ok {
+				return fmt.Errorf("adjustment rule %d not found or inactive", id)
+			}
+			ruleID := rule.ID
+			items = append(items, models.BookingAdjustment{
+				BookingID: booking.ID,
+				RuleID:    &ruleID,
+				Name:      rule.Name,
+				Kind:      rule.Kind,
+				Amount:    rule.Amount,
+				Status:    models.AdjustmentStatusApproved,
+				CreatedBy: by,
+			})
+		}
+	}
+
+	if review {
+		totals := make(map[string]int64, 2)
+		for _, item := range items {
+			totals[item.Kind] += item.Amount
+		}
+		for i := range items {
+			if a.needsApproval(items[i].Kind, booking.Role, totals[items[i].Kind]) {
+				items[i].Status = models.AdjustmentStatusPending
+			}
+		}
+	}
+
+	if err := a.itemRepo.DeleteByBookingTx(tx, booking.ID, true, false); err != nil {
+		return err
+	}
+	if err := a.itemRepo.CreateTx(tx, items); err != nil {
+		return err
+	}
+	return a.recalcTx(tx, booking)
+}
+
+// ReplaceManualTx replaces the manual lines with one bonus and one fine line
+// for the given amounts; zero amounts add no line.
+func (a *Applier) ReplaceManualTx(tx *gorm.DB, booking *models.Booking, bonus, fine int64, by *uint) error {
+	if bonus < 0 || fine < 0 {
+		return errors.New("amounts cannot be negative")
+	}
+
+	items := make([]models.BookingAdjustment, 0, 2)
+	if bonus > 0 {
+		items = append(items, manualItem(booking.ID, models.AdjustmentKindBonus, bonus, by))
+	}
+	if fine > 0 {
+		items = append(items, manualItem(booking.ID, models.AdjustmentKindFine, fine, by))
+	}
+
+	if err := a.itemRepo.DeleteByBookingTx(tx, booking.ID, false, true); err != nil {
+		return err
+	}
+	if err := a.itemRepo.CreateTx(tx, items); err != nil {
+		return err
+	}
+	return a.recalcTx(tx, booking)
+}
+
+// HoldTATx checks a TA entered by a captain. An amount above the approval
+// threshold of the booking role replaces any earlier claim as a pending line,
+// and nil is returned so the booking keeps its current TA until approval.
+// Otherwise ta is returned unchanged for the caller to apply.
+func (a *Applier) HoldTATx(tx *gorm.DB, booking *models.Booking, ta *int64, by *uint) (*int64, error) {
+	if ta == nil {
+		return nil, nil
+	}
+
+	if err := a.itemRepo.DeleteKindTx(tx, booking.ID, models.AdjustmentKindTA); err != nil {
+		return nil, err
+	}
+	if !a.needsApproval(models.AdjustmentKindTA, booking.Role, *ta) {
+		return ta, nil
+	}
+
+	claim := models.BookingAdjustment{
+		BookingID: booking.ID,
+		Name:      models.TAClaimName,
+		Kind:      models.AdjustmentKindTA,
+		Amount:    *ta,
+		Status:    models.AdjustmentStatusPending,
+		CreatedBy: by,
+	}
+	if err := a.itemRepo.CreateTx(tx, []models.BookingAdjustment{claim}); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// DropTAClaimTx removes any TA claim, used when an admin sets the TA directly.
+func (a *Applier) DropTAClaimTx(tx *gorm.DB, booking *models.Booking) error {
+	return a.itemRepo.DeleteKindTx(tx, booking.ID, models.AdjustmentKindTA)
+}
+
+// FindForUpdateTx locks a line for review.
+func (a *Applier) FindForUpdateTx(tx *gorm.DB, id uint) (*models.BookingAdjustment, error) {
+	return a.itemRepo.FindByIDForUpdateTx(tx, id)
+}
+
+// ReviewTx approves or rejects a pending line of the locked booking and
+// recalculates its amounts. An approved TA claim becomes the booking TA.
+// Callers recalculate the total and save the booking.
+func (a *Applier) ReviewTx(tx *gorm.DB, booking *models.Booking, item *models.BookingAdjustment, approve bool, by uint) error {
+	if item.Status != models.AdjustmentStatusPending {
+		return errors.New("adjustment is not pending")
+	}
+
+	now := time.Now()
+	item.ReviewedBy = &by
+	item.ReviewedAt = &now
+	item.Status = models.AdjustmentStatusRejected
+	if approve {
+		item.Status = models.AdjustmentStatusApproved
+	}
+	if err := a.itemRepo.UpdateTx(tx, item); err != nil {
+		return err
+	}
+
+	if approve && item.Kind == models.AdjustmentKindTA {
+		booking.TAAmount = item.Amount
+		booking.TAPolicyID = nil
+		booking.TARule = models.TARuleManual
+		booking.TAOverridden = true
+	}
+	return a.recalcTx(tx, booking)
+}
+
+// PendingQueue lists every pending line, oldest first.
+func (a *Applier) PendingQueue() ([]QueueItem, error) {
+	rows := make([]QueueItem, 0)
+	err := config.DB.
+		Table("booking_adjustments").
+		Select(`
+			booking_adjustments.id,
+			booking_adjustments.booking_id,
+			bookings.event_id,
+			events.event_name,
+			events.date AS event_date,
+			bookings.user_id,
+			users.name AS user_name,
+			bookings.role,
+			booking_adjustments.rule_id,
+			booking_adjustments.name,
+			booking_adjustments.kind,
+			booking_adjustments.amount,
+			booking_adjustments.created_by,
+			booking_adjustments.created_at
+		`).
+		Joins("JOIN bookings ON bookings.id = booking_adjustments.booking_id").
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Joins("JOIN users ON users.id = bookings.user_id").
+		Where(`
+			booking_adjustments.status = ?
+			AND bookings.deleted_at IS NULL
+		`, models.AdjustmentStatusPending).
+		Order("booking_adjustments.created_at ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// PendingFor sums the pending lines of the given bookings. Bookings with
+// nothing pending are left out.
+func (a *Applier) PendingFor(bookingIDs []uint) (map[uint]*Pending, error) {
+	res := make(map[uint]*Pending)
+	if len(bookingIDs) == 0 {
+		return res, nil
+	}
+
+	var rows []struct {
+		BookingID uint
+		Kind      string
+		Total     int64
+	}
+	err := config.DB.
+		Table("booking_adjustments").
+		Select("booking_id, kind, COALESCE(SUM(amount),0) AS total").
+		Where("booking_id IN ? AND status = ?", bookingIDs, models.AdjustmentStatusPending).
+		Group("booking_id, kind").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		p, ok := res[row.BookingID]
+		if !ok {
+			p = &Pending{}
+			res[row.BookingID] = p
+		}
+		switch row.Kind {
+		case models.AdjustmentKindTA:
+			p.TA += row.Total
+		case models.AdjustmentKindBonus:
+			p.Bonus += row.Total
+		case models.AdjustmentKindFine:
+			p.Fine += row.Total
+		}
+	}
+	return res, nil
+}
+
+// ClearTx removes every line, e.g. when the booking is marked absent.
+func (a *Applier) ClearTx(tx *gorm.DB, booking *models.Booking) error {
+	if err := a.itemRepo.DeleteByBookingTx(tx, booking.ID, true, true); err != nil {
+		return err
+	}
+	booking.BonusAmount = 0
+	booking.FineAmount = 0
+	return nil
+}
+
+// ActiveRules lists the rules captains can pick from.
+func (a *Applier) ActiveRules() ([]models.AdjustmentRule, error) {
+	return a.ruleRepo.ListAll(true)
+}
+
+// ListTx returns the lines of the booking.
+func (a *Applier) ListTx(tx *gorm.DB, bookingID uint) ([]models.BookingAdjustment, error) {
+	return a.itemRepo.ListByBookingTx(tx, bookingID)
+}
+
+// BreakdownForEvent totals the approved fine and bonus lines of an event by
+// rule, skipping absent bookings like the wage summary does.
+func (a *Applier) BreakdownForEvent(eventID uint) ([]RuleTotal, error) {
+	var rows []RuleTotal
+	err := config.DB.
+		Table("booking_adjustments").
+		Select(`
+			booking_adjustments.rule_id,
+			booking_adjustments.name,
+			booking_adjustments.kind,
+			COUNT(*)                                   AS count,
+			COALESCE(SUM(booking_adjustments.amount),0) AS total
+		`).
+		Joins("JOIN bookings ON bookings.id = booking_adjustments.booking_id").
+		Where(`
+			bookings.event_id = ?
+			AND bookings.deleted_at IS NULL
+			AND bookings.status != ?
+			AND booking_adjustments.status = ?
+			AND booking_adjustments.kind IN ?
+		`, eventID, models.BookingStatusAbsent, models.AdjustmentStatusApproved,
+			[]string{models.AdjustmentKindBonus, models.AdjustmentKindFine}).
+		Group("booking_adjustments.rule_id, booking_adjustments.name, booking_adjustments.kind").
+		Order("booking_adjustments.kind ASC, total DESC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (a *Applier) recalcTx(tx *gorm.DB, booking *models.Booking) error {
+	items, err := a.itemRepo.ListByBookingTx(tx, booking.ID)
+	if err != nil {
+		return err
+	}
+
+	booking.BonusAmount = 0
+	booking.FineAmount = 0
+	for _, item := range items {
+		if item.Status != models.AdjustmentStatusApproved {
+			continue
+		}
+		switch item.Kind {
+		case models.AdjustmentKindBonus:
+			booking.BonusAmount += item.Amount
+		case models.AdjustmentKindFine:
+			booking.FineAmount += item.Amount
+		}
+	}
+	return nil
+}
+
+func (a *Applier) needsApproval(kind, role string, amount int64) bool {
+	threshold := a.settingRepo.GetInt(models.ApprovalThresholdKey(kind, role), -1)
+	return threshold >= 0 && amount > threshold
+}
+
+func manualItem(bookingID uint, kind string, amount int64, by *uint) models.BookingAdjustment {
+	return models.BookingAdjustment{
+		BookingID: bookingID,
+		Name:      models.ManualAdjustmentName,
+		Kind:      kind,
+		Amount:    amount,
+		Status:    models.AdjustmentStatusApproved,
+		CreatedBy: by,
+	}
+}
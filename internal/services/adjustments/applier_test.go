@@ -0,0 +1,184 @@
+package adjustments
+
+import (
+	"testing"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type fakeItemRepo struct {
+	interfaces.BookingAdjustmentRepository
+	items []models.BookingAdjustment
+}
+
+func (f *fakeItemRepo) ListByBookingTx(tx *gorm.DB, bookingID uint) ([]models.BookingAdjustment, error) {
+	var list []models.BookingAdjustment
+	for _, item := range f.items {
+		if item.BookingID == bookingID {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeItemRepo) UpdateTx(tx *gorm.DB, item *models.BookingAdjustment) error {
+	for i := range f.items {
+		if f.items[i].ID == item.ID {
+			f.items[i] = *item
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+type fakeSettingRepo struct {
+	interfaces.SettingRepository
+	ints map[string]int64
+}
+
+func (f *fakeSettingRepo) GetInt(key string, fallback int64) int64 {
+	if v, ok := f.ints[key]; ok {
+		return v
+	}
+	return fallback
+}
+
+func TestApplierNeedsApproval(t *testing.T) {
+	a := NewApplier(nil, nil, &fakeSettingRepo{ints: map[string]int64{
+		models.ApprovalThresholdKey(models.AdjustmentKindBonus, models.RoleJuniorBoy): 500,
+		models.ApprovalThresholdKey(models.AdjustmentKindFine, models.RoleJuniorBoy):  0,
+	}})
+
+	tests := []struct {
+		name   string
+		kind   string
+		role   string
+		amount int64
+		want   bool
+	}{
+		{"below threshold", models.AdjustmentKindBonus, models.RoleJuniorBoy, 400, false},
+		{"at threshold", models.AdjustmentKindBonus, models.RoleJuniorBoy, 500, false},
+		{"above threshold", models.AdjustmentKindBonus, models.RoleJuniorBoy, 501, true},
+		{"zero threshold holds any amount", models.AdjustmentKindFine, models.RoleJuniorBoy, 1, true},
+		{"zero threshold lets nothing through", models.AdjustmentKindFine, models.RoleJuniorBoy, 0, false},
+		{"no threshold for the kind", models.AdjustmentKindTA, models.RoleJuniorBoy, 10000, false},
+		{"no threshold for the role", models.AdjustmentKindBonus, models.RoleCaptain, 10000, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.needsApproval(tt.kind, tt.role, tt.amount); got != tt.want {
+				t.Errorf("needsApproval(%q, %q, %d) = %v, want %v", tt.kind, tt.role, tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplierReviewTx(t *testing.T) {
+	const bookingID = 1
+
+	tests := []struct {
+		name        string
+		item        models.BookingAdjustment
+		approve     bool
+		wantErr     bool
+		wantStatus  string
+		wantBonus   int64
+		wantFine    int64
+		wantTA      int64
+		wantTAFixed bool
+	}{
+		{
+			name:       "approved bonus is added",
+			item:       models.BookingAdjustment{ID: 10, Kind: models.AdjustmentKindBonus, Amount: 700, Status: models.AdjustmentStatusPending},
+			approve:    true,
+			wantStatus: models.AdjustmentStatusApproved,
+			wantBonus:  800,
+			wantFine:   50,
+			wantTA:     120,
+		},
+		{
+			name:       "rejected fine is left out",
+			item:       models.BookingAdjustment{ID: 10, Kind: models.AdjustmentKindFine, Amount: 900, Status: models.AdjustmentStatusPending},
+			approve:    false,
+			wantStatus: models.AdjustmentStatusRejected,
+			wantBonus:  100,
+			wantFine:   50,
+			wantTA:     120,
+		},
+		{
+			name:        "approved TA claim becomes the booking TA",
+			item:        models.BookingAdjustment{ID: 10, Name: models.TAClaimName, Kind: models.AdjustmentKindTA, Amount: 400, Status: models.AdjustmentStatusPending},
+			approve:     true,
+			wantStatus:  models.AdjustmentStatusApproved,
+			wantBonus:   100,
+			wantFine:    50,
+			wantTA:      400,
+			wantTAFixed: true,
+		},
+		{
+			name:       "rejected TA claim keeps the booking TA",
+			item:       models.BookingAdjustment{ID: 10, Name: models.TAClaimName, Kind: models.AdjustmentKindTA, Amount: 400, Status: models.AdjustmentStatusPending},
+			approve:    false,
+			wantStatus: models.AdjustmentStatusRejected,
+			wantBonus:  100,
+			wantFine:   50,
+			wantTA:     120,
+		},
+		{
+			name:       "line already reviewed",
+			item:       models.BookingAdjustment{ID: 10, Kind: models.AdjustmentKindBonus, Amount: 700, Status: models.AdjustmentStatusRejected},
+			approve:    true,
+			wantErr:    true,
+			wantStatus: models.AdjustmentStatusRejected,
+			wantBonus:  0,
+			wantFine:   0,
+			wantTA:     120,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := tt.item
+			item.BookingID = bookingID
+			repo := &fakeItemRepo{items: []models.BookingAdjustment{
+				{ID: 1, BookingID: bookingID, Kind: models.AdjustmentKindBonus, Amount: 100, Status: models.AdjustmentStatusApproved},
+				{ID: 2, BookingID: bookingID, Kind: models.AdjustmentKindFine, Amount: 50, Status: models.AdjustmentStatusApproved},
+				{ID: 3, BookingID: bookingID, Kind: models.AdjustmentKindBonus, Amount: 300, Status: models.AdjustmentStatusRejected},
+				item,
+			}}
+			policyID := uint(5)
+			booking := &models.Booking{ID: bookingID, TAAmount: 120, TAPolicyID: &policyID, TARule: "policy"}
+			a := NewApplier(nil, repo, &fakeSettingRepo{})
+
+			err := a.ReviewTx(nil, booking, &item, tt.approve, 9)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReviewTx() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			stored := repo.items[len(repo.items)-1]
+			if stored.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", stored.Status, tt.wantStatus)
+			}
+			if !tt.wantErr && (stored.ReviewedBy == nil || *stored.ReviewedBy != 9 || stored.ReviewedAt == nil) {
+				t.Errorf("reviewer not recorded: %+v", stored)
+			}
+			if booking.BonusAmount != tt.wantBonus || booking.FineAmount != tt.wantFine {
+				t.Errorf("bonus, fine = %d, %d; want %d, %d", booking.BonusAmount, booking.FineAmount, tt.wantBonus, tt.wantFine)
+			}
+			if booking.TAAmount != tt.wantTA {
+				t.Errorf("TA = %d, want %d", booking.TAAmount, tt.wantTA)
+			}
+			if tt.wantTAFixed {
+				if booking.TAPolicyID != nil || booking.TARule != models.TARuleManual || !booking.TAOverridden {
+					t.Errorf("TA not marked manual: policy %v rule %q overridden %v", booking.TAPolicyID, booking.TARule, booking.TAOverridden)
+				}
+			} else if booking.TAPolicyID == nil || booking.TAOverridden {
+				t.Errorf("TA source changed: policy %v overridden %v", booking.TAPolicyID, booking.TAOverridden)
+			}
+		})
+	}
+}
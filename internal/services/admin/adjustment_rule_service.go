@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"errors"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// AdjustmentRuleService manages the catalogue of named fines and bonuses.
+// Bookings keep a copy of the rule, so edits only affect later updates.
+type AdjustmentRuleService struct {
+	repo interfaces.AdjustmentRuleRepository
+}
+
+func NewAdjustmentRuleService(repo interfaces.AdjustmentRuleRepository) *AdjustmentRuleService {
+	return &AdjustmentRuleService{repo: repo}
+}
+
+func (s *AdjustmentRuleService) ListRules(activeOnly bool) ([]models.AdjustmentRule, error) {
+	return s.repo.ListAll(activeOnly)
+}
+
+func (s *AdjustmentRuleService) CreateRule(rule *models.AdjustmentRule) error {
+	return s.repo.Create(rule)
+}
+
+func (s *AdjustmentRuleService) UpdateRule(input *models.AdjustmentRule) error {
+	old, err := s.repo.FindByID(input.ID)
+	if err != nil {
+		return errors.New("adjustment rule not found")
+	}
+
+	input.CreatedAt = old.CreatedAt
+	return s.repo.Update(input)
+}
+
+func (s *AdjustmentRuleService) DeleteRule(id uint) error {
+	if err := s.repo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("adjustment rule not found")
+		}
+		return err
+	}
+	return nil
+}
@@ -6,23 +6,39 @@ import (
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/waitlist"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type AdminBookingService struct {
-	bookingRepo interfaces.BookingRepository
-	eventRepo   interfaces.EventRepository
+	bookingRepo     interfaces.BookingRepository
+	eventRepo       interfaces.EventRepository
+	waitlistService *waitlist.WaitlistService
+	wageResolver    *wages.WageResolver
+	taCalculator    *travel.TACalculator
+	adjustments     *adjustments.Applier
 }
 
 func NewAdminBookingService(
 	bookingRepo interfaces.BookingRepository,
 	eventRepo interfaces.EventRepository,
+	waitlistService *waitlist.WaitlistService,
+	wageResolver *wages.WageResolver,
+	taCalculator *travel.TACalculator,
+	adjustments *adjustments.Applier,
 ) *AdminBookingService {
 	return &AdminBookingService{
-		bookingRepo: bookingRepo,
-		eventRepo:   eventRepo,
+		bookingRepo:     bookingRepo,
+		eventRepo:       eventRepo,
+		waitlistService: waitlistService,
+		wageResolver:    wageResolver,
+		taCalculator:    taCalculator,
+		adjustments:     adjustments,
 	}
 }
 
@@ -46,9 +62,15 @@ func (s *AdminBookingService) ListEventBookings(
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where("bookings.event_id = ? AND bookings.deleted_at IS NULL", eventID).
@@ -88,9 +110,15 @@ func (s *AdminBookingService) ListEventBookingsByStatus(
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where(`
@@ -125,9 +153,15 @@ func (s *AdminBookingService) SearchEventBookingsByName(
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where(`
@@ -166,19 +200,13 @@ func (s *AdminBookingService) RemoveUserFromEvent(eventID, bookingID uint) error
 			return errors.New("booking removal allowed only for upcoming events")
 		}
 
-		// restore slot
-		switch booking.Role {
-		case models.RoleCaptain:
-			event.RemainingCaptains++
-		case models.RoleSubCaptain:
-			event.RemainingSubCaptains++
-		case models.RoleMainBoy:
-			event.RemainingMainBoys++
-		case models.RoleJuniorBoy:
-			event.RemainingJuniors++
+		// restore slot and hand it to the next waiting user
+		event.ReleaseSlot(booking.Role)
+		if err := s.waitlistService.PromoteTx(tx, event); err != nil {
+			return err
 		}
 
-		if err := tx.Save(event).Error; err != nil {
+		if err := s.eventRepo.SaveTx(tx, event); err != nil {
 			return err
 		}
 
@@ -186,6 +214,83 @@ func (s *AdminBookingService) RemoveUserFromEvent(eventID, bookingID uint) error
 	})
 }
 
+//
+// ---------------- LIST CANCELLATIONS ----------------
+// SELF CANCELLATIONS BY WORKERS AND CAPTAINS (OPTIONALLY LATE ONLY)
+//
+func (s *AdminBookingService) ListCancellations(
+	eventID uint,
+	lateOnly bool,
+) ([]CancellationRowResponse, error) {
+
+	rows := make([]CancellationRowResponse, 0)
+
+	q := config.DB.
+		Table("booking_cancellations").
+		Select(`
+			booking_cancellations.id,
+			booking_cancellations.event_id,
+			events.event_name,
+			booking_cancellations.user_id,
+			users.name AS user_name,
+			users.phone AS user_phone,
+			booking_cancellations.role,
+			booking_cancellations.minutes_before_reporting,
+			booking_cancellations.is_late,
+			booking_cancellations.cancelled_at
+		`).
+		Joins("JOIN users ON users.id = booking_cancellations.user_id").
+		Joins("JOIN events ON events.id = booking_cancellations.event_id")
+
+	if eventID != 0 {
+		q = q.Where("booking_cancellations.event_id = ?", eventID)
+	}
+	if lateOnly {
+		q = q.Where("booking_cancellations.is_late = ?", true)
+	}
+
+	err := q.Order("booking_cancellations.cancelled_at DESC").Scan(&rows).Error
+	return rows, err
+}
+
+//
+// ---------------- REJECTED SELF CHECK-INS ----------------
+//
+func (s *AdminBookingService) ListRejectedCheckIns(
+	eventID uint,
+) ([]RejectedCheckInRow, error) {
+
+	rows := make([]RejectedCheckInRow, 0)
+
+	q := config.DB.
+		Table("check_in_attempts").
+		Select(`
+			check_in_attempts.id,
+			check_in_attempts.booking_id,
+			check_in_attempts.event_id,
+			events.event_name,
+			check_in_attempts.user_id,
+			users.name AS user_name,
+			users.phone AS user_phone,
+			check_in_attempts.latitude,
+			check_in_attempts.longitude,
+			check_in_attempts.distance_meters,
+			check_in_attempts.radius_meters,
+			check_in_attempts.reason,
+			check_in_attempts.created_at
+		`).
+		Joins("JOIN users ON users.id = check_in_attempts.user_id").
+		Joins("JOIN events ON events.id = check_in_attempts.event_id").
+		Where("check_in_attempts.accepted = ?", false)
+
+	if eventID != 0 {
+		q = q.Where("check_in_attempts.event_id = ?", eventID)
+	}
+
+	err := q.Order("check_in_attempts.created_at DESC").Scan(&rows).Error
+	return rows, err
+}
+
 //
 // ---------------- UPDATE ATTENDANCE ----------------
 // RULE: ONLY ONGOING EVENTS
@@ -194,9 +299,11 @@ func (s *AdminBookingService) RemoveUserFromEvent(eventID, bookingID uint) error
 func (s *AdminBookingService) UpdateAttendance(
 	bookingID uint,
 	status string,
-	taAmount int64,
+	taAmount *int64,
 	bonusAmount int64,
 	fineAmount int64,
+	ruleIDs []uint,
+	adminID uint,
 ) (*models.Booking, error) {
 
 	var updatedBooking *models.Booking
@@ -230,15 +337,24 @@ func (s *AdminBookingService) UpdateAttendance(
 		}
 
 		booking.Status = status
+		if status == models.BookingStatusPresent && booking.CheckInMethod == "" {
+			booking.CheckInMethod = models.CheckInMethodManual
+		}
 
 		// ---------------- ABSENT ----------------
 		if status == models.BookingStatusAbsent {
 			booking.BaseAmount = 0
 			booking.ExtraAmount = 0
 			booking.TAAmount = 0
-			booking.BonusAmount = 0
-			booking.FineAmount = 0
-			booking.TotalAmount = 0
+			booking.TAPolicyID = nil
+			booking.TADistanceKm = nil
+			booking.TARule = ""
+			booking.TAOverridden = false
+			booking.RecalcTotal()
+
+			if err := s.adjustments.ClearTx(tx, &booking); err != nil {
+				return err
+			}
 
 			if err := tx.Save(&booking).Error; err != nil {
 				return err
@@ -249,11 +365,11 @@ func (s *AdminBookingService) UpdateAttendance(
 		}
 
 		// ---------------- VALIDATE AMOUNTS ----------------
-		if taAmount < 0 || bonusAmount < 0 || fineAmount < 0 {
+		if (taAmount != nil && *taAmount < 0) || bonusAmount < 0 || fineAmount < 0 {
 			return errors.New("amounts cannot be negative")
 		}
 
-		// ---------------- RESTORE BASE AMOUNT FROM USER ----------------
+		// ---------------- BASE AMOUNT (WAGE ON EVENT DATE) ----------------
 		var user models.User
 		if err := tx.
 			Clauses(clause.Locking{Strength: "UPDATE"}).
@@ -262,10 +378,23 @@ func (s *AdminBookingService) UpdateAttendance(
 			return errors.New("user not found")
 		}
 
-		booking.BaseAmount = user.CurrentWage
-		booking.TAAmount = taAmount
-		booking.BonusAmount = bonusAmount
-		booking.FineAmount = fineAmount
+		booking.BaseAmount = s.wageResolver.BaseWage(tx, &user, booking.Role, event.Date)
+
+		// ---------------- TRAVEL ALLOWANCE ----------------
+		if taAmount != nil {
+			if err := s.adjustments.DropTAClaimTx(tx, &booking); err != nil {
+				return err
+			}
+		}
+		s.taCalculator.ApplyTx(tx, &booking, &user, event, taAmount)
+
+		// ---------------- FINES & BONUSES ----------------
+		if err := s.adjustments.ReplaceRulesTx(tx, &booking, ruleIDs, &adminID, false); err != nil {
+			return err
+		}
+		if err := s.adjustments.ReplaceManualTx(tx, &booking, bonusAmount, fineAmount, &adminID); err != nil {
+			return err
+		}
 
 		// ---------------- EXTRA WAGE ----------------
 		if event.LongWork {
@@ -275,16 +404,7 @@ func (s *AdminBookingService) UpdateAttendance(
 		}
 
 		// ---------------- TOTAL CALCULATION ----------------
-		booking.TotalAmount =
-			booking.BaseAmount +
-				booking.ExtraAmount +
-				booking.TAAmount +
-				booking.BonusAmount -
-				booking.FineAmount
-
-		if booking.TotalAmount < 0 {
-			booking.TotalAmount = 0
-		}
+		booking.RecalcTotal()
 
 		if err := tx.Save(&booking).Error; err != nil {
 			return err
@@ -312,6 +432,7 @@ func (s *AdminBookingService) GetEventWageSummary(
 			COALESCE(SUM(ta_amount),0)     AS total_ta_amount,
 			COALESCE(SUM(bonus_amount),0)  AS total_bonus_amount,
 			COALESCE(SUM(fine_amount),0)   AS total_fine_amount,
+			COALESCE(SUM(advance_deduction),0) AS total_advance_amount,
 			COALESCE(SUM(total_amount),0)  AS grand_total_amount
 		`).
 		Where(`
@@ -325,5 +446,10 @@ func (s *AdminBookingService) GetEventWageSummary(
 		return nil, err
 	}
 
+	summary.Rules, err = s.adjustments.BreakdownForEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &summary, nil
 }
\ No newline at end of file
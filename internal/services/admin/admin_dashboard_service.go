@@ -11,6 +11,16 @@ type DashboardSummary struct {
 	OngoingEvents   int64 `json:"ongoing_events"`
 	UpcomingEvents  int64 `json:"upcoming_events"`
 	TotalUsers      int64 `json:"total_users"`
+
+	UpcomingStaffing []RoleStaffing `json:"upcoming_staffing"`
+}
+
+// RoleStaffing is the slot fill of one role across upcoming events.
+type RoleStaffing struct {
+	Role     string `json:"role"`
+	Required int64  `json:"required"`
+	Booked   int64  `json:"booked"`
+	Open     int64  `json:"open"`
 }
 
 type MonthlyEventCount struct {
@@ -66,6 +76,23 @@ func (s *DashboardService) GetSummary() (*DashboardSummary, error) {
 		return nil, err
 	}
 
+	summary.UpcomingStaffing = make([]RoleStaffing, 0)
+	if err := db.
+		Table("event_role_requirements").
+		Select(`
+			event_role_requirements.role,
+			COALESCE(SUM(event_role_requirements.required),0) AS required,
+			COALESCE(SUM(event_role_requirements.required - event_role_requirements.remaining),0) AS booked,
+			COALESCE(SUM(event_role_requirements.remaining),0) AS open
+		`).
+		Joins("JOIN events ON events.id = event_role_requirements.event_id").
+		Where("events.status = ? AND events.deleted_at IS NULL", models.EventStatusUpcoming).
+		Group("event_role_requirements.role").
+		Order("event_role_requirements.role ASC").
+		Scan(&summary.UpcomingStaffing).Error; err != nil {
+		return nil, err
+	}
+
 	return &summary, nil
 }
 
@@ -2,36 +2,66 @@ package admin
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/services/waitlist"
+	"event-management-backend/internal/utils"
 
 	"gorm.io/gorm"
 )
 
+var errNoEventChanges = errors.New("no changes detected")
+
 type AdminEventService struct {
-	repo interfaces.EventRepository
+	repo            interfaces.EventRepository
+	waitlistService *waitlist.WaitlistService
+	lifecycleRepo   interfaces.EventLifecycleLogRepository
+	wageResolver    *wages.WageResolver
 }
 
-func NewAdminEventService(repo interfaces.EventRepository) *AdminEventService {
-	return &AdminEventService{repo: repo}
+func NewAdminEventService(
+	repo interfaces.EventRepository,
+	waitlistService *waitlist.WaitlistService,
+	lifecycleRepo interfaces.EventLifecycleLogRepository,
+	wageResolver *wages.WageResolver,
+) *AdminEventService {
+	return &AdminEventService{
+		repo:            repo,
+		waitlistService: waitlistService,
+		lifecycleRepo:   lifecycleRepo,
+		wageResolver:    wageResolver,
+	}
 }
 
 // ---------------- CREATE ----------------
 
 func (s *AdminEventService) CreateEvent(event *models.Event) error {
+	return s.CreateEventTx(config.DB, event)
+}
+
+// CreateEventTx lets callers such as the series generator create several
+// events in one transaction.
+func (s *AdminEventService) CreateEventTx(tx *gorm.DB, event *models.Event) error {
 	today := time.Now().Truncate(24 * time.Hour)
 	if event.Date.Before(today) {
 		return errors.New("event date cannot be in the past")
 	}
 
 	event.Status = models.EventStatusUpcoming
-	event.RemainingCaptains = event.RequiredCaptains
-	event.RemainingSubCaptains = event.RequiredSubCaptains
-	event.RemainingMainBoys = event.RequiredMainBoys
-	event.RemainingJuniors = event.RequiredJuniors
+	reqs := make([]models.EventRoleRequirement, 0, len(event.RoleRequirements))
+	for _, req := range event.RoleRequirements {
+		if req.Required == 0 {
+			continue
+		}
+		req.Remaining = req.Required
+		reqs = append(reqs, req)
+	}
+	event.RoleRequirements = reqs
 
 	if !event.TransportProvided {
 		event.TransportType = ""
@@ -40,7 +70,69 @@ func (s *AdminEventService) CreateEvent(event *models.Event) error {
 		event.ExtraWageAmount = 0
 	}
 
-	return s.repo.Create(event)
+	if !event.HasLocation() {
+		setLocationFromLink(event)
+	}
+
+	return s.repo.CreateTx(tx, event)
+}
+
+// ---------------- CLONE ----------------
+
+// CloneEvent copies an event of any status into a new upcoming event with
+// fresh slot counters. A zero date keeps the original date.
+func (s *AdminEventService) CloneEvent(id uint, date time.Time, name string) (*models.Event, error) {
+	src, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	if date.IsZero() {
+		date = src.Date
+	}
+	if name == "" {
+		name = src.EventName
+	}
+
+	reqs := make([]models.EventRoleRequirement, 0, len(src.RoleRequirements))
+	for _, r := range src.RoleRequirements {
+		reqs = append(reqs, models.EventRoleRequirement{Role: r.Role, Required: r.Required})
+	}
+
+	clone := &models.Event{
+		EventName:         name,
+		Date:              date,
+		TimeSlot:          src.TimeSlot,
+		ReportingTime:     src.ReportingTime,
+		WorkType:          src.WorkType,
+		LocationLink:      src.LocationLink,
+		Latitude:          src.Latitude,
+		Longitude:         src.Longitude,
+		RadiusMeters:      src.RadiusMeters,
+		RoleRequirements:  reqs,
+		LongWork:          src.LongWork,
+		TransportProvided: src.TransportProvided,
+		TransportType:     src.TransportType,
+		ExtraWageAmount:   src.ExtraWageAmount,
+	}
+
+	if err := s.CreateEvent(clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// setLocationFromLink fills the venue coordinates from the maps link, or
+// clears them when the link has none.
+func setLocationFromLink(event *models.Event) {
+	lat, lng, ok := utils.ParseCoordinates(event.LocationLink)
+	if !ok {
+		event.Latitude = nil
+		event.Longitude = nil
+		return
+	}
+	event.Latitude = &lat
+	event.Longitude = &lng
 }
 
 // ---------------- READ ----------------
@@ -53,10 +145,21 @@ func (s *AdminEventService) ListEvents(status, date string) ([]models.Event, err
 	return s.repo.ListAll(status, date)
 }
 
+// ListLifecycleLogs returns transitions made by the background scheduler.
+func (s *AdminEventService) ListLifecycleLogs(eventID uint, action string) ([]models.EventLifecycleLog, error) {
+	return s.lifecycleRepo.List(eventID, action)
+}
+
 // ---------------- UPDATE ----------------
 
 func (s *AdminEventService) UpdateEvent(input *models.Event) error {
-	old, err := s.repo.FindByID(input.ID)
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		return s.updateEventTx(tx, input)
+	})
+}
+
+func (s *AdminEventService) updateEventTx(tx *gorm.DB, input *models.Event) error {
+	old, err := s.repo.FindByIDForUpdate(tx, input.ID)
 	if err != nil {
 		return err
 	}
@@ -94,48 +197,54 @@ func (s *AdminEventService) UpdateEvent(input *models.Event) error {
 
 	if input.LocationLink != "" && old.LocationLink != input.LocationLink {
 		old.LocationLink = input.LocationLink
+		if !input.HasLocation() {
+			setLocationFromLink(old)
+		}
 		changed = true
 	}
 
-	// -------- REQUIRED COUNTS (SAFE UPDATE) --------
+	// -------- GEOFENCE --------
 
-	if input.RequiredCaptains != old.RequiredCaptains {
-		booked := old.RequiredCaptains - old.RemainingCaptains
-		if input.RequiredCaptains < booked {
-			return errors.New("required captains less than already booked")
-		}
-		old.RequiredCaptains = input.RequiredCaptains
-		old.RemainingCaptains = input.RequiredCaptains - booked
+	if input.HasLocation() &&
+		(!old.HasLocation() || *old.Latitude != *input.Latitude || *old.Longitude != *input.Longitude) {
+		old.Latitude = input.Latitude
+		old.Longitude = input.Longitude
 		changed = true
 	}
 
-	if input.RequiredSubCaptains != old.RequiredSubCaptains {
-		booked := old.RequiredSubCaptains - old.RemainingSubCaptains
-		if input.RequiredSubCaptains < booked {
-			return errors.New("required sub captains less than already booked")
-		}
-		old.RequiredSubCaptains = input.RequiredSubCaptains
-		old.RemainingSubCaptains = input.RequiredSubCaptains - booked
+	if input.RadiusMeters != 0 && old.RadiusMeters != input.RadiusMeters {
+		old.RadiusMeters = input.RadiusMeters
 		changed = true
 	}
 
-	if input.RequiredMainBoys != old.RequiredMainBoys {
-		booked := old.RequiredMainBoys - old.RemainingMainBoys
-		if input.RequiredMainBoys < booked {
-			return errors.New("required main boys less than already booked")
+	// -------- REQUIRED COUNTS (SAFE UPDATE) --------
+
+	for _, in := range input.RoleRequirements {
+		req := old.Requirement(in.Role)
+		if req == nil {
+			if in.Required == 0 {
+				continue
+			}
+			old.RoleRequirements = append(old.RoleRequirements, models.EventRoleRequirement{
+				EventID:   old.ID,
+				Role:      in.Role,
+				Required:  in.Required,
+				Remaining: in.Required,
+			})
+			changed = true
+			continue
+		}
+
+		if in.Required == req.Required {
+			continue
 		}
-		old.RequiredMainBoys = input.RequiredMainBoys
-		old.RemainingMainBoys = input.RequiredMainBoys - booked
-		changed = true
-	}
 
-	if input.RequiredJuniors != old.RequiredJuniors {
-		booked := old.RequiredJuniors - old.RemainingJuniors
-		if input.RequiredJuniors < booked {
-			return errors.New("required juniors less than already booked")
+		booked := req.Booked()
+		if in.Required < booked {
+			return fmt.Errorf("required %s count less than already booked", models.RoleLabel(in.Role))
 		}
-		old.RequiredJuniors = input.RequiredJuniors
-		old.RemainingJuniors = input.RequiredJuniors - booked
+		req.Required = in.Required
+		req.Remaining = in.Required - booked
 		changed = true
 	}
 
@@ -168,42 +277,50 @@ func (s *AdminEventService) UpdateEvent(input *models.Event) error {
 	}
 
 	if !changed {
-		return errors.New("no changes detected")
+		return errNoEventChanges
 	}
 
-	return s.repo.Update(old)
+	// raised counts free up slots for waiting users
+	if err := s.waitlistService.PromoteTx(tx, old); err != nil {
+		return err
+	}
+
+	return s.repo.SaveTx(tx, old)
 }
 
 // ---------------- STATUS CONTROL ----------------
+// Status changes hold the event row lock like UpdateEvent and bookings do,
+// so a scheduler tick cannot race an admin edit or a booking.
 
 func (s *AdminEventService) StartEvent(id uint) error {
-	event, err := s.repo.FindByID(id)
-	if err != nil {
-		return err
-	}
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		event, err := s.repo.FindByIDForUpdate(tx, id)
+		if err != nil {
+			return err
+		}
 
-	if event.Status != models.EventStatusUpcoming {
-		return errors.New("only upcoming events can be started")
-	}
+		if event.Status != models.EventStatusUpcoming {
+			return errors.New("only upcoming events can be started")
+		}
 
-	event.Status = models.EventStatusOngoing
-	return s.repo.Update(event)
+		event.Status = models.EventStatusOngoing
+		return s.repo.SaveTx(tx, event)
+	})
 }
 
 func (s *AdminEventService) CompleteEvent(id uint) error {
-	event, err := s.repo.FindByID(id)
-	if err != nil {
-		return err
-	}
-
-	if event.Status != models.EventStatusOngoing {
-		return errors.New("only ongoing events can be completed")
-	}
-
 	return config.DB.Transaction(func(tx *gorm.DB) error {
+		event, err := s.repo.FindByIDForUpdate(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if event.Status != models.EventStatusOngoing {
+			return errors.New("only ongoing events can be completed")
+		}
 
 		event.Status = models.EventStatusCompleted
-		if err := tx.Save(event).Error; err != nil {
+		if err := s.repo.SaveTx(tx, event); err != nil {
 			return err
 		}
 
@@ -234,22 +351,34 @@ func (s *AdminEventService) CompleteEvent(id uint) error {
 			return err
 		}
 
-		return nil
+		// ---------------- SENIORITY WAGE TIERS ----------------
+		var userIDs []uint
+		if err := tx.
+			Model(&models.Booking{}).
+			Where("event_id = ? AND status = ? AND deleted_at IS NULL", id, models.BookingStatusCompleted).
+			Pluck("user_id", &userIDs).Error; err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("completed event #%d", id)
+		return s.wageResolver.SyncUsersTx(tx, userIDs, models.WageChangeSourceSeniority, reason)
 	})
 }
 
 func (s *AdminEventService) CancelEvent(id uint) error {
-	event, err := s.repo.FindByID(id)
-	if err != nil {
-		return err
-	}
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		event, err := s.repo.FindByIDForUpdate(tx, id)
+		if err != nil {
+			return err
+		}
 
-	if event.Status != models.EventStatusUpcoming {
-		return errors.New("only upcoming events can be cancelled")
-	}
+		if event.Status != models.EventStatusUpcoming {
+			return errors.New("only upcoming events can be cancelled")
+		}
 
-	event.Status = models.EventStatusCancelled
-	return s.repo.Update(event)
+		event.Status = models.EventStatusCancelled
+		return s.repo.SaveTx(tx, event)
+	})
 }
 
 // ---------------- DELETE ----------------
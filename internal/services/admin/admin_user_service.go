@@ -4,8 +4,10 @@ import (
 	"errors"
 	"time"
 
+	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
 	"event-management-backend/internal/utils"
 
 	"gorm.io/gorm"
@@ -14,15 +16,24 @@ import (
 type AdminUserService struct {
 	repo         interfaces.UserRepository
 	roleWageRepo interfaces.RoleWageRepository
+	wageResolver *wages.WageResolver
+	refreshRepo  interfaces.RefreshTokenRepository
+	securityRepo interfaces.SecurityEventRepository
 }
 
 func NewAdminUserService(
 	repo interfaces.UserRepository,
 	wagesRepo interfaces.RoleWageRepository,
+	wageResolver *wages.WageResolver,
+	refreshRepo interfaces.RefreshTokenRepository,
+	securityRepo interfaces.SecurityEventRepository,
 ) *AdminUserService {
 	return &AdminUserService{
 		repo:         repo,
 		roleWageRepo: wagesRepo,
+		wageResolver: wageResolver,
+		refreshRepo:  refreshRepo,
+		securityRepo: securityRepo,
 	}
 }
 
@@ -41,7 +52,7 @@ func (s *AdminUserService) CreateUser(input *models.User) error {
 	} else {
 		input.AdminRoleID = nil
 		if rw, err := s.roleWageRepo.FindByRole(input.Role); err == nil {
-			input.CurrentWage = rw.Wage
+			input.CurrentWage = s.wageResolver.WageFor(config.DB, input, input.Role, rw.Wage, time.Now())
 		} else {
 			input.CurrentWage = 0
 		}
@@ -76,6 +87,8 @@ func (s *AdminUserService) UpdateUser(input *models.User) error {
 	}
 
 	changed := false
+	// role, admin role and status changes end the user's current tokens
+	revoke := false
 
 	if input.Name != "" && input.Name != old.Name {
 		old.Name = input.Name
@@ -97,13 +110,14 @@ func (s *AdminUserService) UpdateUser(input *models.User) error {
 		}
 		old.Role = input.Role
 		changed = true
+		revoke = true
 
 		if old.Role == models.RoleAdmin {
 			old.CurrentWage = 0
 		} else {
 			old.AdminRoleID = nil
 			if rw, err := s.roleWageRepo.FindByRole(input.Role); err == nil {
-				old.CurrentWage = rw.Wage
+				old.CurrentWage = s.wageResolver.WageFor(config.DB, old, old.Role, rw.Wage, time.Now())
 			} else {
 				old.CurrentWage = 0
 			}
@@ -118,15 +132,18 @@ func (s *AdminUserService) UpdateUser(input *models.User) error {
 			if old.AdminRoleID == nil || *input.AdminRoleID != *old.AdminRoleID {
 				old.AdminRoleID = input.AdminRoleID
 				changed = true
+				revoke = true
 			}
 		} else if old.AdminRoleID != nil {
 			old.AdminRoleID = nil
 			changed = true
+			revoke = true
 		}
 	} else {
 		if old.AdminRoleID != nil {
 			old.AdminRoleID = nil
 			changed = true
+			revoke = true
 		}
 	}
 
@@ -151,6 +168,7 @@ func (s *AdminUserService) UpdateUser(input *models.User) error {
 		}
 		old.Status = input.Status
 		changed = true
+		revoke = true
 	}
 
 	if input.DOB != nil && (old.DOB == nil || !old.DOB.Equal(*input.DOB)) {
@@ -167,7 +185,13 @@ func (s *AdminUserService) UpdateUser(input *models.User) error {
 		return errors.New("no changes detected")
 	}
 
-	return s.repo.Update(old)
+	if err := s.repo.Update(old); err != nil {
+		return err
+	}
+	if revoke {
+		return s.repo.BumpTokenVersion(old.ID)
+	}
+	return nil
 }
 
 // ---------------- BLOCK USER ----------------
@@ -182,7 +206,43 @@ func (s *AdminUserService) BlockUser(id uint) error {
 	}
 
 	user.Status = models.StatusBlocked
-	return s.repo.Update(user)
+	if err := s.repo.Update(user); err != nil {
+		return err
+	}
+	if err := s.repo.BumpTokenVersion(id); err != nil {
+		return err
+	}
+
+	// a blocked user should not keep refreshing on other devices
+	return s.refreshRepo.DeleteByUserID(id)
+}
+
+// ---------------- REVOKE SESSIONS ----------------
+// Access tokens already issued stay valid until they expire.
+func (s *AdminUserService) RevokeSessions(id uint) error {
+	if _, err := s.repo.FindByID(id); err != nil {
+		return errors.New("user not found")
+	}
+	return s.refreshRepo.DeleteByUserID(id)
+}
+
+// ---------------- SECURITY EVENTS ----------------
+func (s *AdminUserService) ListSecurityEvents(userID uint, kind string) ([]models.SecurityEvent, error) {
+	return s.securityRepo.List(userID, kind)
+}
+
+// ---------------- BANK DETAILS ----------------
+func (s *AdminUserService) UpdateBankDetails(id uint, accountName, accountNumber, ifsc, bankName string) error {
+	if _, err := s.repo.FindByID(id); err != nil {
+		return errors.New("user not found")
+	}
+
+	return s.repo.UpdateFields(id, map[string]interface{}{
+		"bank_account_name":   accountName,
+		"bank_account_number": accountNumber,
+		"bank_ifsc":           ifsc,
+		"bank_name":           bankName,
+	})
 }
 
 // ---------------- UNBLOCK USER ----------------
@@ -209,7 +269,12 @@ func (s *AdminUserService) SoftDeleteUser(id uint) error {
 	if user.DeletedAt.Valid {
 		return errors.New("user already deleted")
 	}
-	return s.repo.SoftDelete(id)
+	if err := s.repo.SoftDelete(id); err != nil {
+		return err
+	}
+
+	// access tokens stop at the next version check, refresh tokens now
+	return s.refreshRepo.DeleteByUserID(id)
 }
 
 // ---------------- RESET PASSWORD ----------------
@@ -222,14 +287,21 @@ func (s *AdminUserService) ResetPassword(id uint, newPassword string) error {
 	if utils.CheckPasswordHash(newPassword, user.Password) {
 		return errors.New("new password cannot be same as old password")
 	}
+	if err := utils.CheckPasswordStrength(newPassword, user.Phone); err != nil {
+		return err
+	}
 
 	hashed, err := utils.HashPassword(newPassword)
 	if err != nil {
 		return err
 	}
 
-	user.Password = hashed
-	return s.repo.Update(user)
+	if err := s.repo.SetPasswordTx(config.DB, id, hashed); err != nil {
+		return err
+	}
+
+	// whoever knew the old password is logged out everywhere
+	return s.refreshRepo.DeleteByUserID(id)
 }
 
 // ---------------- FILTER BY ROLE ----------------
@@ -251,22 +323,13 @@ func (s *AdminUserService) UpdateUserRole(userID uint, role string, adminRoleID
     }
 
     changed := false
+    roleChanged := false
 
     // 1. Role Change Logic
     if role != "" && role != user.Role {
         user.Role = role
         changed = true
-
-        // Wage Logic
-        if role == models.RoleAdmin {
-            user.CurrentWage = 0
-        } else {
-            if rw, err := s.roleWageRepo.FindByRole(role); err == nil {
-                user.CurrentWage = rw.Wage
-            } else {
-                user.CurrentWage = 0
-            }
-        }
+        roleChanged = true
     }
 
     // 2. AdminRoleID Logic
@@ -291,8 +354,23 @@ func (s *AdminUserService) UpdateUserRole(userID uint, role string, adminRoleID
         return errors.New("no changes detected in clearance")
     }
 
-    // 3. Save using our specific UpdateRole method
-    return s.repo.UpdateRole(user)
+    // 3. Save the role; the wage follows through the resolver so the
+    // change is logged and tiers and overrides apply
+    err = config.DB.Transaction(func(tx *gorm.DB) error {
+        if err := s.repo.UpdateRoleTx(tx, user); err != nil {
+            return err
+        }
+        if !roleChanged {
+            return nil
+        }
+        return s.wageResolver.SyncUsersTx(tx, []uint{user.ID}, models.WageChangeSourceRole, "role changed to "+role)
+    })
+    if err != nil {
+        return err
+    }
+
+    // 4. Existing tokens carry the old role and permissions
+    return s.repo.BumpTokenVersion(user.ID)
 }
 
 func (s *AdminUserService) RemoveUserPhoto(id uint) (string, error) {
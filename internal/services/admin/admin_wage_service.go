@@ -6,6 +6,7 @@ import (
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/adjustments"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -14,15 +15,18 @@ import (
 type WageService struct {
 	bookingRepo interfaces.BookingRepository
 	eventRepo   interfaces.EventRepository
+	adjustments *adjustments.Applier
 }
 
 func NewWageService(
 	bookingRepo interfaces.BookingRepository,
 	eventRepo interfaces.EventRepository,
+	adjustments *adjustments.Applier,
 ) *WageService {
 	return &WageService{
 		bookingRepo: bookingRepo,
 		eventRepo:   eventRepo,
+		adjustments: adjustments,
 	}
 }
 
@@ -31,14 +35,17 @@ func NewWageService(
 // RULES:
 // - ONLY COMPLETED EVENTS
 // - Only TA / Bonus / Fine
+// - BONUS / FINE REPLACE THE MANUAL LINES, RULE LINES ARE KEPT
 // - ZERO allowed
 // - Negative NOT allowed
+// - Not once included in a payout
 //
 func (s *WageService) OverrideWage(
 	bookingID uint,
 	taAmount int64,
 	bonusAmount int64,
 	fineAmount int64,
+	adminID uint,
 ) error {
 
 	// ---------------- VALIDATION ----------------
@@ -68,28 +75,80 @@ func (s *WageService) OverrideWage(
 			return errors.New("wage override allowed only for completed events")
 		}
 
+		// ---------------- PAYROLL LOCK ----------------
+		if booking.PayoutID != nil {
+			return errors.New("booking is locked by a closed payroll period")
+		}
+
 		// ---------------- ABSENT SAFETY ----------------
 		if booking.Status == models.BookingStatusAbsent {
 			return errors.New("cannot override wage for absent booking")
 		}
 
 		// ---------------- APPLY OVERRIDE ----------------
+		if taAmount != booking.TAAmount {
+			booking.TAPolicyID = nil
+			booking.TARule = models.TARuleManual
+			booking.TAOverridden = true
+
+			if err := s.adjustments.DropTAClaimTx(tx, &booking); err != nil {
+				return err
+			}
+		}
 		booking.TAAmount = taAmount
-		booking.BonusAmount = bonusAmount
-		booking.FineAmount = fineAmount
+
+		if err := s.adjustments.ReplaceManualTx(tx, &booking, bonusAmount, fineAmount, &adminID); err != nil {
+			return err
+		}
 
 		// ---------------- RECALCULATE TOTAL ----------------
-		booking.TotalAmount =
-			booking.BaseAmount +
-				booking.ExtraAmount +
-				booking.TAAmount +
-				booking.BonusAmount -
-				booking.FineAmount
-
-		if booking.TotalAmount < 0 {
-			booking.TotalAmount = 0
+		booking.RecalcTotal()
+
+		return tx.Save(&booking).Error
+	})
+}
+// ---------------- APPROVAL QUEUE ----------------
+func (s *WageService) ListPendingAdjustments() ([]adjustments.QueueItem, error) {
+	return s.adjustments.PendingQueue()
+}
+
+//
+// ---------------- REVIEW ADJUSTMENT (ADMIN) ----------------
+// RULES:
+// - ONLY PENDING LINES
+// - APPROVED LINES COUNT TOWARDS THE TOTAL, REJECTED ONES ARE KEPT FOR HISTORY
+// - Not once included in a payout
+//
+func (s *WageService) ReviewAdjustment(adjustmentID uint, approve bool, adminID uint) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+
+		// ---------------- LOCK LINE ----------------
+		item, err := s.adjustments.FindForUpdateTx(tx, adjustmentID)
+		if err != nil {
+			return errors.New("adjustment not found")
+		}
+
+		// ---------------- LOCK BOOKING ----------------
+		var booking models.Booking
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", item.BookingID).
+			First(&booking).Error; err != nil {
+			return errors.New("booking not found")
 		}
 
+		// ---------------- PAYROLL LOCK ----------------
+		if booking.PayoutID != nil {
+			return errors.New("booking is locked by a closed payroll period")
+		}
+
+		if err := s.adjustments.ReviewTx(tx, &booking, item, approve, adminID); err != nil {
+			return err
+		}
+
+		// ---------------- RECALCULATE TOTAL ----------------
+		booking.RecalcTotal()
+
 		return tx.Save(&booking).Error
 	})
-}
\ No newline at end of file
+}
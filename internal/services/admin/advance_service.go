@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// AdvanceService issues cash advances and keeps their ledger. Deductions
+// from wages happen when payroll is closed, see advances.Ledger.
+type AdvanceService struct {
+	repo     interfaces.AdvanceRepository
+	userRepo interfaces.UserRepository
+}
+
+func NewAdvanceService(
+	repo interfaces.AdvanceRepository,
+	userRepo interfaces.UserRepository,
+) *AdvanceService {
+	return &AdvanceService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (s *AdvanceService) ListAdvances(userID uint, status string) ([]models.Advance, error) {
+	return s.repo.List(userID, status)
+}
+
+func (s *AdvanceService) GetAdvance(id uint) (*models.Advance, error) {
+	advance, err := s.repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("advance not found")
+	}
+	return advance, nil
+}
+
+func (s *AdvanceService) IssueAdvance(userID uint, input *models.Advance, adminID uint) (*models.Advance, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if !models.IsStaffRole(user.Role) {
+		return nil, errors.New("advances apply to staff users only")
+	}
+
+	input.UserID = userID
+	input.Balance = input.Amount
+	input.Status = models.AdvanceStatusActive
+	input.CreatedBy = &adminID
+	if input.IssuedAt.IsZero() {
+		input.IssuedAt = time.Now()
+	}
+
+	if err := s.repo.CreateTx(config.DB, input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// UpdateSchedule changes how an active advance is recovered.
+func (s *AdvanceService) UpdateSchedule(id uint, perEventCap, installment int64, note string) (*models.Advance, error) {
+	var updated *models.Advance
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		advance, err := s.repo.FindForUpdate(tx, id)
+		if err != nil {
+			return errors.New("advance not found")
+		}
+		if advance.Status != models.AdvanceStatusActive {
+			return errors.New("only active advances can be changed")
+		}
+
+		advance.PerEventCap = perEventCap
+		advance.InstallmentAmount = installment
+		advance.Note = note
+		if err := s.repo.UpdateTx(tx, advance); err != nil {
+			return err
+		}
+
+		updated = advance
+		return nil
+	})
+
+	return updated, err
+}
+
+// RecordRepayment books cash paid back by the user outside payroll.
+func (s *AdvanceService) RecordRepayment(id uint, amount int64, note string, adminID uint) (*models.Advance, error) {
+	var updated *models.Advance
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		advance, err := s.repo.FindForUpdate(tx, id)
+		if err != nil {
+			return errors.New("advance not found")
+		}
+		if advance.Status != models.AdvanceStatusActive {
+			return errors.New("advance is not active")
+		}
+		if amount > advance.Balance {
+			return errors.New("repayment exceeds outstanding balance")
+		}
+
+		if err := s.repo.CreateRepaymentTx(tx, &models.AdvanceRepayment{
+			AdvanceID: advance.ID,
+			UserID:    advance.UserID,
+			Source:    models.RepaymentSourceManual,
+			Amount:    amount,
+			Note:      note,
+			CreatedBy: &adminID,
+		}); err != nil {
+			return err
+		}
+
+		advance.Balance -= amount
+		if advance.Balance == 0 {
+			now := time.Now()
+			advance.Status = models.AdvanceStatusSettled
+			advance.SettledAt = &now
+		}
+		if err := s.repo.UpdateTx(tx, advance); err != nil {
+			return err
+		}
+
+		updated = advance
+		return nil
+	})
+
+	return updated, err
+}
+
+// WriteOff stops recovering an advance. The remaining balance is kept on
+// record but no longer counts as outstanding.
+func (s *AdvanceService) WriteOff(id uint, note string) (*models.Advance, error) {
+	var updated *models.Advance
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		advance, err := s.repo.FindForUpdate(tx, id)
+		if err != nil {
+			return errors.New("advance not found")
+		}
+		if advance.Status != models.AdvanceStatusActive {
+			return errors.New("advance is not active")
+		}
+
+		now := time.Now()
+		advance.Status = models.AdvanceStatusWrittenOff
+		advance.SettledAt = &now
+		if note != "" {
+			advance.Note = note
+		}
+		if err := s.repo.UpdateTx(tx, advance); err != nil {
+			return err
+		}
+
+		updated = advance
+		return nil
+	})
+
+	return updated, err
+}
@@ -1,5 +1,11 @@
 package admin
 
+import (
+	"time"
+
+	"event-management-backend/internal/services/adjustments"
+)
+
 
 type AttendanceRowResponse struct {
 	BookingID  uint   `json:"booking_id"`
@@ -8,12 +14,19 @@ type AttendanceRowResponse struct {
 	Role       string `json:"role"`
 	Status     string `json:"status"`
 
-	BaseAmount  int64 `json:"base_amount"`
-	ExtraAmount int64 `json:"extra_amount"`
-	TAAmount    int64 `json:"ta_amount"`
-	BonusAmount int64 `json:"bonus_amount"`
-	FineAmount  int64 `json:"fine_amount"`
-	TotalAmount int64 `json:"total_amount"`
+	BaseAmount   int64  `json:"base_amount"`
+	ExtraAmount  int64  `json:"extra_amount"`
+	TAAmount     int64  `json:"ta_amount"`
+	TARule       string `json:"ta_rule"`
+	TAOverridden bool   `json:"ta_overridden"`
+	BonusAmount  int64  `json:"bonus_amount"`
+	FineAmount   int64  `json:"fine_amount"`
+	TotalAmount  int64  `json:"total_amount"`
+
+	CheckedInAt   *time.Time `json:"checked_in_at"`
+	CheckedOutAt  *time.Time `json:"checked_out_at"`
+	CheckInMethod string     `json:"check_in_method"`
+	WorkedHours   float64    `json:"worked_hours"`
 }
 
 type EventWageSummary struct {
@@ -23,5 +36,38 @@ type EventWageSummary struct {
 	TotalTAAmount      int64 `json:"total_ta_amount"`
 	TotalBonusAmount   int64 `json:"total_bonus_amount"`
 	TotalFineAmount    int64 `json:"total_fine_amount"`
+	// advances recovered at payroll close, already taken off the grand total
+	TotalAdvanceAmount int64 `json:"total_advance_amount"`
 	GrandTotalAmount   int64 `json:"grand_total_amount"`
-}
\ No newline at end of file
+
+	Rules []adjustments.RuleTotal `gorm:"-" json:"rules"`
+}
+
+type CancellationRowResponse struct {
+	ID                     uint      `json:"id"`
+	EventID                uint      `json:"event_id"`
+	EventName              string    `json:"event_name"`
+	UserID                 uint      `json:"user_id"`
+	UserName               string    `json:"user_name"`
+	UserPhone              string    `json:"user_phone"`
+	Role                   string    `json:"role"`
+	MinutesBeforeReporting int64     `json:"minutes_before_reporting"`
+	IsLate                 bool      `json:"is_late"`
+	CancelledAt            time.Time `json:"cancelled_at"`
+}
+
+type RejectedCheckInRow struct {
+	ID             uint      `json:"id"`
+	BookingID      uint      `json:"booking_id"`
+	EventID        uint      `json:"event_id"`
+	EventName      string    `json:"event_name"`
+	UserID         uint      `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	UserPhone      string    `json:"user_phone"`
+	Latitude       float64   `json:"latitude"`
+	Longitude      float64   `json:"longitude"`
+	DistanceMeters float64   `json:"distance_meters"`
+	RadiusMeters   uint      `json:"radius_meters"`
+	Reason         string    `json:"reason"`
+	CreatedAt      time.Time `json:"created_at"`
+}
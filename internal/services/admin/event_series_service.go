@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type EventSeriesService struct {
+	seriesRepo   interfaces.EventSeriesRepository
+	eventRepo    interfaces.EventRepository
+	eventService *AdminEventService
+}
+
+func NewEventSeriesService(
+	seriesRepo interfaces.EventSeriesRepository,
+	eventRepo interfaces.EventRepository,
+	eventService *AdminEventService,
+) *EventSeriesService {
+	return &EventSeriesService{
+		seriesRepo:   seriesRepo,
+		eventRepo:    eventRepo,
+		eventService: eventService,
+	}
+}
+
+// ---------------- CREATE ----------------
+
+// CreateSeries stores the recurrence rule and creates one event per
+// occurrence from the given template, all in one transaction.
+func (s *EventSeriesService) CreateSeries(series *models.EventSeries, template *models.Event) error {
+	dates, err := series.Occurrences()
+	if err != nil {
+		return err
+	}
+	if len(dates) == 0 {
+		return errors.New("series has no occurrences")
+	}
+
+	series.Status = models.SeriesStatusActive
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.seriesRepo.CreateTx(tx, series); err != nil {
+			return err
+		}
+
+		for _, d := range dates {
+			event := *template
+			event.Date = d
+			event.SeriesID = &series.ID
+			event.RoleRequirements = append([]models.EventRoleRequirement(nil), template.RoleRequirements...)
+
+			if err := s.eventService.CreateEventTx(tx, &event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ---------------- READ ----------------
+
+func (s *EventSeriesService) GetSeries(id uint) (*models.EventSeries, error) {
+	return s.seriesRepo.FindByID(id)
+}
+
+func (s *EventSeriesService) ListSeries(status string) ([]models.EventSeries, error) {
+	return s.seriesRepo.ListAll(status)
+}
+
+// ---------------- UPDATE OCCURRENCES ----------------
+
+// UpdateOccurrences applies input to one event of the series ("this"), to it
+// and every later occurrence ("following") or to the whole series ("all").
+// Only upcoming occurrences are touched; the date can only be moved for a
+// single occurrence. Returns the number of events changed.
+func (s *EventSeriesService) UpdateOccurrences(seriesID, eventID uint, scope string, input *models.Event) (int, error) {
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return 0, errors.New("series not found")
+	}
+
+	var target *models.Event
+	for i := range series.Events {
+		if series.Events[i].ID == eventID {
+			target = &series.Events[i]
+			break
+		}
+	}
+	if target == nil {
+		return 0, errors.New("event does not belong to this series")
+	}
+
+	if scope == models.SeriesScopeThis {
+		input.ID = eventID
+		if err := s.eventService.UpdateEvent(input); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+
+	if scope != models.SeriesScopeFollowing && scope != models.SeriesScopeAll {
+		return 0, errors.New("invalid scope")
+	}
+	if !input.Date.IsZero() {
+		return 0, errors.New("date can only be changed for a single occurrence")
+	}
+
+	ids := make([]uint, 0, len(series.Events))
+	for _, e := range series.Events {
+		if e.Status != models.EventStatusUpcoming {
+			continue
+		}
+		if scope == models.SeriesScopeFollowing && e.Date.Before(target.Date) {
+			continue
+		}
+		ids = append(ids, e.ID)
+	}
+	if len(ids) == 0 {
+		return 0, errors.New("no upcoming events to update")
+	}
+
+	updated := 0
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			occ := *input
+			occ.ID = id
+			occ.RoleRequirements = append([]models.EventRoleRequirement(nil), input.RoleRequirements...)
+
+			err := s.eventService.updateEventTx(tx, &occ)
+			if errors.Is(err, errNoEventChanges) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if updated == 0 {
+		return 0, errNoEventChanges
+	}
+	return updated, nil
+}
+
+// ---------------- CANCEL ----------------
+
+// CancelSeries stops the series and cancels its upcoming events from today
+// on. Past, running and completed occurrences are left as they are.
+func (s *EventSeriesService) CancelSeries(id uint) (int64, error) {
+	series, err := s.seriesRepo.FindByID(id)
+	if err != nil {
+		return 0, errors.New("series not found")
+	}
+	if series.Status == models.SeriesStatusCancelled {
+		return 0, errors.New("series already cancelled")
+	}
+
+	// local midnight, the zone event dates are read in
+	today := dateOnly(time.Now().In(time.Local))
+	var cancelled int64
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		series.Status = models.SeriesStatusCancelled
+		if err := s.seriesRepo.UpdateTx(tx, series); err != nil {
+			return err
+		}
+
+		res := tx.Model(&models.Event{}).
+			Where("series_id = ? AND status = ? AND date >= ? AND deleted_at IS NULL",
+				id, models.EventStatusUpcoming, today).
+			Update("status", models.EventStatusCancelled)
+		if res.Error != nil {
+			return res.Error
+		}
+		cancelled = res.RowsAffected
+		return nil
+	})
+	return cancelled, err
+}
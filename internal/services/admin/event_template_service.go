@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"errors"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type EventTemplateService struct {
+	repo         interfaces.EventTemplateRepository
+	eventService *AdminEventService
+}
+
+func NewEventTemplateService(
+	repo interfaces.EventTemplateRepository,
+	eventService *AdminEventService,
+) *EventTemplateService {
+	return &EventTemplateService{
+		repo:         repo,
+		eventService: eventService,
+	}
+}
+
+// ---------------- CRUD ----------------
+
+func (s *EventTemplateService) CreateTemplate(template *models.EventTemplate) error {
+	normalizeTemplate(template)
+	if err := s.checkName(template.Name, 0); err != nil {
+		return err
+	}
+	return s.repo.Create(template)
+}
+
+func (s *EventTemplateService) ListTemplates() ([]models.EventTemplate, error) {
+	return s.repo.ListAll()
+}
+
+func (s *EventTemplateService) GetTemplate(id uint) (*models.EventTemplate, error) {
+	return s.repo.FindByID(id)
+}
+
+func (s *EventTemplateService) UpdateTemplate(input *models.EventTemplate) error {
+	old, err := s.repo.FindByID(input.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("template not found")
+		}
+		return err
+	}
+
+	input.CreatedAt = old.CreatedAt
+	normalizeTemplate(input)
+	if err := s.checkName(input.Name, input.ID); err != nil {
+		return err
+	}
+	return s.repo.Update(input)
+}
+
+func (s *EventTemplateService) checkName(name string, excludeID uint) error {
+	taken, err := s.repo.NameTaken(name, excludeID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New("a template with this name already exists")
+	}
+	return nil
+}
+
+func (s *EventTemplateService) DeleteTemplate(id uint) error {
+	if err := s.repo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("template not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// ---------------- CREATE EVENT ----------------
+
+// CreateEventFromTemplate builds an event from the template on the date in
+// overrides. Non-empty override fields replace template values; listed roles
+// replace the template count for that role.
+func (s *EventTemplateService) CreateEventFromTemplate(
+	templateID uint,
+	overrides *models.Event,
+	extraWage *int64,
+) (*models.Event, error) {
+
+	template, err := s.repo.FindByID(templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("template not found")
+		}
+		return nil, err
+	}
+
+	event := template.NewEvent(overrides.Date)
+
+	if overrides.EventName != "" {
+		event.EventName = overrides.EventName
+	}
+	if overrides.TimeSlot != "" {
+		event.TimeSlot = overrides.TimeSlot
+	}
+	if overrides.ReportingTime != "" {
+		event.ReportingTime = overrides.ReportingTime
+	}
+	if overrides.WorkType != "" {
+		event.WorkType = overrides.WorkType
+	}
+	if overrides.LocationLink != "" {
+		event.LocationLink = overrides.LocationLink
+	}
+	if extraWage != nil {
+		if !event.LongWork && *extraWage != 0 {
+			return nil, errors.New("extra wage must be zero when long work is false")
+		}
+		event.ExtraWageAmount = *extraWage
+	}
+
+	for _, in := range overrides.RoleRequirements {
+		if req := event.Requirement(in.Role); req != nil {
+			req.Required = in.Required
+			continue
+		}
+		event.RoleRequirements = append(event.RoleRequirements, in)
+	}
+
+	if err := checkEventComplete(event); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventService.CreateEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ---------------- HELPERS ----------------
+
+func normalizeTemplate(t *models.EventTemplate) {
+	roles := make([]models.EventTemplateRole, 0, len(t.Roles))
+	for _, r := range t.Roles {
+		if r.Required == 0 {
+			continue
+		}
+		roles = append(roles, r)
+	}
+	t.Roles = roles
+
+	if !t.TransportProvided {
+		t.TransportType = ""
+	}
+	if !t.LongWork {
+		t.ExtraWageAmount = 0
+	}
+}
+
+// checkEventComplete enforces the fields CreateEventRequest requires, since
+// templates may leave some of them blank.
+func checkEventComplete(e *models.Event) error {
+	if e.EventName == "" {
+		return errors.New("event name is required")
+	}
+	if e.TimeSlot == "" {
+		return errors.New("time slot is required")
+	}
+	if e.ReportingTime == "" {
+		return errors.New("reporting time is required")
+	}
+	if e.WorkType == "" {
+		return errors.New("work type is required")
+	}
+
+	var total uint
+	for _, req := range e.RoleRequirements {
+		total += req.Required
+	}
+	if total == 0 {
+		return errors.New("at least one worker is required")
+	}
+	return nil
+}
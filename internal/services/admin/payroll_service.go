@@ -0,0 +1,197 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/advances"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PayrollService struct {
+	repo   interfaces.PayrollRepository
+	ledger *advances.Ledger
+}
+
+func NewPayrollService(repo interfaces.PayrollRepository, ledger *advances.Ledger) *PayrollService {
+	return &PayrollService{repo: repo, ledger: ledger}
+}
+
+// ---------------- PERIODS ----------------
+
+func (s *PayrollService) CreatePeriod(period *models.PayrollPeriod) error {
+	overlap, err := s.repo.HasOverlap(period.StartDate, period.EndDate)
+	if err != nil {
+		return err
+	}
+	if overlap {
+		return errors.New("payroll period overlaps an existing period")
+	}
+
+	period.Status = models.PayrollPeriodOpen
+	return s.repo.CreatePeriod(period)
+}
+
+func (s *PayrollService) ListPeriods(status string) ([]models.PayrollPeriod, error) {
+	return s.repo.ListPeriods(status)
+}
+
+func (s *PayrollService) GetPeriod(id uint) (*models.PayrollPeriod, error) {
+	return s.repo.FindPeriodByID(id)
+}
+
+//
+// ---------------- CLOSE PERIOD ----------------
+// Aggregates every completed, not yet paid-out booking whose event date falls
+// in the period into one payout per user and locks those bookings.
+// Outstanding advances are deducted from the bookings first. A period with
+// adjustment lines still awaiting approval cannot be closed, since locked
+// bookings can no longer be reviewed.
+//
+func (s *PayrollService) ClosePeriod(periodID, adminID uint) (*models.PayrollPeriod, error) {
+	var closed *models.PayrollPeriod
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		period, err := s.repo.FindPeriodForUpdate(tx, periodID)
+		if err != nil {
+			return errors.New("payroll period not found")
+		}
+		if period.Status != models.PayrollPeriodOpen {
+			return errors.New("payroll period already closed")
+		}
+
+		var bookings []models.Booking
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "bookings"}}).
+			Joins("JOIN events ON events.id = bookings.event_id").
+			Where(`
+				bookings.status = ?
+				AND bookings.payout_id IS NULL
+				AND bookings.deleted_at IS NULL
+				AND events.status = ?
+				AND events.deleted_at IS NULL
+				AND DATE(events.date) BETWEEN DATE(?) AND DATE(?)
+			`,
+				models.BookingStatusCompleted,
+				models.EventStatusCompleted,
+				period.StartDate,
+				period.EndDate,
+			).
+			Order("bookings.user_id ASC, events.date ASC").
+			Find(&bookings).Error; err != nil {
+			return err
+		}
+
+		if len(bookings) > 0 {
+			bookingIDs := make([]uint, 0, len(bookings))
+			for _, b := range bookings {
+				bookingIDs = append(bookingIDs, b.ID)
+			}
+			var pending int64
+			if err := tx.Model(&models.BookingAdjustment{}).
+				Where("booking_id IN ? AND status = ?", bookingIDs, models.AdjustmentStatusPending).
+				Distinct("booking_id").
+				Count(&pending).Error; err != nil {
+				return err
+			}
+			if pending > 0 {
+				return fmt.Errorf("%d booking(s) in this period have adjustments awaiting approval", pending)
+			}
+		}
+
+		byUser := make(map[uint][]models.Booking)
+		order := make([]uint, 0)
+		for _, b := range bookings {
+			if _, ok := byUser[b.UserID]; !ok {
+				order = append(order, b.UserID)
+			}
+			byUser[b.UserID] = append(byUser[b.UserID], b)
+		}
+
+		for _, userID := range order {
+			userBookings := byUser[userID]
+
+			if err := s.ledger.DeductTx(tx, period.ID, userID, userBookings); err != nil {
+				return err
+			}
+
+			payout := &models.Payout{
+				PeriodID:     period.ID,
+				UserID:       userID,
+				BookingCount: len(userBookings),
+				Status:       models.PayoutStatusPending,
+			}
+			for _, b := range userBookings {
+				payout.TotalAmount += b.TotalAmount
+				payout.Items = append(payout.Items, models.PayoutItem{
+					BookingID: b.ID,
+					EventID:   b.EventID,
+					Amount:    b.TotalAmount,
+				})
+			}
+
+			if err := s.repo.CreatePayoutTx(tx, payout); err != nil {
+				return err
+			}
+
+			ids := make([]uint, 0, len(userBookings))
+			for _, b := range userBookings {
+				ids = append(ids, b.ID)
+			}
+			if err := tx.Model(&models.Booking{}).
+				Where("id IN ?", ids).
+				Update("payout_id", payout.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		period.Status = models.PayrollPeriodClosed
+		period.ClosedAt = &now
+		period.ClosedBy = &adminID
+		if err := s.repo.UpdatePeriodTx(tx, period); err != nil {
+			return err
+		}
+
+		closed = period
+		return nil
+	})
+
+	return closed, err
+}
+
+// ---------------- PAYOUTS ----------------
+
+func (s *PayrollService) ListPayouts(periodID uint, status string) ([]models.Payout, error) {
+	return s.repo.ListPayouts(periodID, status)
+}
+
+func (s *PayrollService) GetPayout(id uint) (*models.Payout, error) {
+	return s.repo.FindPayoutByID(id)
+}
+
+func (s *PayrollService) MarkPaid(payoutID, adminID uint, reference string) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		payout, err := s.repo.FindPayoutForUpdate(tx, payoutID)
+		if err != nil {
+			return errors.New("payout not found")
+		}
+		if payout.Status == models.PayoutStatusPaid {
+			return errors.New("payout already marked as paid")
+		}
+
+		now := time.Now()
+		payout.Status = models.PayoutStatusPaid
+		payout.Reference = reference
+		payout.PaidAt = &now
+		payout.PaidBy = &adminID
+
+		return s.repo.UpdatePayoutTx(tx, payout)
+	})
+}
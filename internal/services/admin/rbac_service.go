@@ -8,10 +8,11 @@ import (
 type RoleService struct {
 	roleRepo interfaces.RoleRepository
 	permRepo interfaces.PermissionRepository
+	userRepo interfaces.UserRepository
 }
 
-func NewRoleService(r interfaces.RoleRepository, p interfaces.PermissionRepository) *RoleService {
-	return &RoleService{roleRepo: r, permRepo: p}
+func NewRoleService(r interfaces.RoleRepository, p interfaces.PermissionRepository, u interfaces.UserRepository) *RoleService {
+	return &RoleService{roleRepo: r, permRepo: p, userRepo: u}
 }
 
 func (s *RoleService) CreatePermission(slug, desc string) error {
@@ -56,9 +57,18 @@ func (s *RoleService) UpdateRole(id uint, name string, permIDs []uint) error {
     role.Name = name
     role.Permissions = perms
 
-    return s.roleRepo.UpdateRole(role)
+    if err := s.roleRepo.UpdateRole(role); err != nil {
+        return err
+    }
+
+    // admins holding this role get the new permissions on their next refresh
+    return s.userRepo.BumpTokenVersionByAdminRole(id)
 }
 
 func (s *RoleService) DeleteRole(id uint) error {
+	// bumped first, the delete clears admin_role_id on its holders
+	if err := s.userRepo.BumpTokenVersionByAdminRole(id); err != nil {
+		return err
+	}
 	return s.roleRepo.DeleteRole(id)
 }
\ No newline at end of file
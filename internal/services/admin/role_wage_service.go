@@ -2,26 +2,35 @@ package admin
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
 
 	"gorm.io/gorm"
 )
 
 type RoleWageService struct {
-	repo     interfaces.RoleWageRepository
-	userRepo interfaces.UserRepository
+	repo         interfaces.RoleWageRepository
+	userRepo     interfaces.UserRepository
+	revisionRepo interfaces.RoleWageRevisionRepository
+	wageResolver *wages.WageResolver
 }
 
 func NewRoleWageService(
 	repo interfaces.RoleWageRepository,
 	userRepo interfaces.UserRepository,
+	revisionRepo interfaces.RoleWageRevisionRepository,
+	wageResolver *wages.WageResolver,
 ) *RoleWageService {
 	return &RoleWageService{
-		repo:     repo,
-		userRepo: userRepo,
+		repo:         repo,
+		userRepo:     userRepo,
+		revisionRepo: revisionRepo,
+		wageResolver: wageResolver,
 	}
 }
 
@@ -29,37 +38,167 @@ func (s *RoleWageService) GetAll() ([]models.RoleWage, error) {
 	return s.repo.GetAll()
 }
 
+// Update changes the role wage effective today.
 func (s *RoleWageService) Update(role string, wage int64) error {
+	existing, err := s.validateChange(role, wage)
+	if err != nil {
+		return err
+	}
+
+	if existing.Wage == wage {
+		return errors.New("no changes detected")
+	}
+
+	today := dateOnly(time.Now())
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		revision, err := s.revisionRepo.FindByRoleAndDate(role, today)
+		if err != nil {
+			revision = &models.RoleWageRevision{Role: role, EffectiveFrom: today}
+		}
+		return s.applyTx(tx, existing, revision, wage)
+	})
+}
+
+// ---------------- REVISIONS ----------------
+
+func (s *RoleWageService) ListRevisions(role string) ([]models.RoleWageRevision, error) {
 	if !models.ValidateRole(role) {
-		return errors.New("invalid role")
+		return nil, errors.New("invalid role")
 	}
+	return s.revisionRepo.ListByRole(role)
+}
 
-	if wage <= 0 {
-		return errors.New("wage must be greater than zero")
+// ScheduleChange records a wage that takes effect on effectiveFrom. A change
+// effective today is applied at once; later ones wait for the scheduler.
+func (s *RoleWageService) ScheduleChange(
+	role string,
+	wage int64,
+	effectiveFrom time.Time,
+	adminID uint,
+) (*models.RoleWageRevision, error) {
+
+	existing, err := s.validateChange(role, wage)
+	if err != nil {
+		return nil, err
 	}
 
-	existing, err := s.repo.FindByRole(role)
+	today := dateOnly(time.Now())
+	effectiveFrom = dateOnly(effectiveFrom)
+	if effectiveFrom.Before(today) {
+		return nil, errors.New("effective date cannot be in the past")
+	}
+
+	if _, err := s.revisionRepo.FindByRoleAndDate(role, effectiveFrom); err == nil {
+		return nil, errors.New("a wage change is already scheduled for this date")
+	}
+
+	revision := &models.RoleWageRevision{
+		Role:          role,
+		Wage:          wage,
+		EffectiveFrom: effectiveFrom,
+		CreatedBy:     &adminID,
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if effectiveFrom.After(today) {
+			return s.revisionRepo.CreateTx(tx, revision)
+		}
+		return s.applyTx(tx, existing, revision, wage)
+	})
 	if err != nil {
-		return errors.New("role not found")
+		return nil, err
 	}
+	return revision, nil
+}
 
-	if existing.Wage == wage {
-		return errors.New("no changes detected")
+// CancelRevision removes a scheduled change that has not been applied yet.
+func (s *RoleWageService) CancelRevision(id uint) error {
+	revision, err := s.revisionRepo.FindByID(id)
+	if err != nil {
+		return errors.New("wage revision not found")
 	}
+	if revision.Applied {
+		return errors.New("applied wage revisions cannot be removed")
+	}
+	return s.revisionRepo.Delete(id)
+}
 
-	return config.DB.Transaction(func(tx *gorm.DB) error {
-		existing.Wage = wage
-		if err := tx.Save(existing).Error; err != nil {
-			return err
+// ApplyDueRevisions applies every scheduled revision that is now effective.
+// Called by the background scheduler.
+func (s *RoleWageService) ApplyDueRevisions(now time.Time) (int, error) {
+	due, err := s.revisionRepo.ListDue(now)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for i := range due {
+		revision := &due[i]
+
+		existing, err := s.repo.FindByRole(revision.Role)
+		if err != nil {
+			continue
 		}
 
-		if err := tx.
-			Model(&models.User{}).
-			Where("role = ? AND deleted_at IS NULL", role).
-			Update("current_wage", wage).Error; err != nil {
-			return err
+		if err := config.DB.Transaction(func(tx *gorm.DB) error {
+			return s.applyTx(tx, existing, revision, revision.Wage)
+		}); err != nil {
+			return applied, err
 		}
+		applied++
+	}
+	return applied, nil
+}
 
-		return nil
-	})
-}
\ No newline at end of file
+// ---------------- HELPERS ----------------
+
+func (s *RoleWageService) validateChange(role string, wage int64) (*models.RoleWage, error) {
+	if !models.ValidateRole(role) {
+		return nil, errors.New("invalid role")
+	}
+
+	if wage <= 0 {
+		return nil, errors.New("wage must be greater than zero")
+	}
+
+	existing, err := s.repo.FindByRole(role)
+	if err != nil {
+		return nil, errors.New("role not found")
+	}
+	return existing, nil
+}
+
+// applyTx makes wage the current role wage, recomputes the wage of its users
+// and stores the revision as applied.
+func (s *RoleWageService) applyTx(
+	tx *gorm.DB,
+	existing *models.RoleWage,
+	revision *models.RoleWageRevision,
+	wage int64,
+) error {
+	existing.Wage = wage
+	if err := tx.Save(existing).Error; err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("role wage changed to %d", wage)
+	if err := s.wageResolver.SyncRoleTx(tx, existing.Role, models.WageChangeSourceRoleWage, reason); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	revision.Wage = wage
+	revision.Applied = true
+	revision.AppliedAt = &now
+
+	if revision.ID == 0 {
+		return s.revisionRepo.CreateTx(tx, revision)
+	}
+	return s.revisionRepo.UpdateTx(tx, revision)
+}
+
+func dateOnly(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
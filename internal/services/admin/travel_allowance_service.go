@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"errors"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/travel"
+
+	"gorm.io/gorm"
+)
+
+// TravelAllowanceService manages starting points and TA policies used by the
+// TA calculator.
+type TravelAllowanceService struct {
+	pointRepo    interfaces.StartingPointRepository
+	policyRepo   interfaces.TAPolicyRepository
+	taCalculator *travel.TACalculator
+}
+
+func NewTravelAllowanceService(
+	pointRepo interfaces.StartingPointRepository,
+	policyRepo interfaces.TAPolicyRepository,
+	taCalculator *travel.TACalculator,
+) *TravelAllowanceService {
+	return &TravelAllowanceService{
+		pointRepo:    pointRepo,
+		policyRepo:   policyRepo,
+		taCalculator: taCalculator,
+	}
+}
+
+// ---------------- STARTING POINTS ----------------
+
+func (s *TravelAllowanceService) ListStartingPoints() ([]models.StartingPoint, error) {
+	return s.pointRepo.ListAll()
+}
+
+func (s *TravelAllowanceService) CreateStartingPoint(point *models.StartingPoint) error {
+	if err := s.pointRepo.Create(point); err != nil {
+		return errors.New("starting point already exists")
+	}
+	return nil
+}
+
+func (s *TravelAllowanceService) UpdateStartingPoint(input *models.StartingPoint) error {
+	old, err := s.pointRepo.FindByID(input.ID)
+	if err != nil {
+		return errors.New("starting point not found")
+	}
+
+	input.CreatedAt = old.CreatedAt
+	return s.pointRepo.Update(input)
+}
+
+func (s *TravelAllowanceService) DeleteStartingPoint(id uint) error {
+	if err := s.pointRepo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("starting point not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// ---------------- POLICIES ----------------
+
+func (s *TravelAllowanceService) ListPolicies() ([]models.TAPolicy, error) {
+	return s.policyRepo.ListAll()
+}
+
+func (s *TravelAllowanceService) CreatePolicy(policy *models.TAPolicy) error {
+	if err := s.policyRepo.Create(policy); err != nil {
+		return errors.New("a TA policy already exists for this transport type")
+	}
+	return nil
+}
+
+func (s *TravelAllowanceService) UpdatePolicy(input *models.TAPolicy) error {
+	old, err := s.policyRepo.FindByID(input.ID)
+	if err != nil {
+		return errors.New("TA policy not found")
+	}
+
+	input.CreatedAt = old.CreatedAt
+	return s.policyRepo.Update(input)
+}
+
+func (s *TravelAllowanceService) DeletePolicy(id uint) error {
+	if err := s.policyRepo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("TA policy not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// ---------------- PROPOSALS ----------------
+
+func (s *TravelAllowanceService) ListProposals(eventID uint) ([]travel.BookingProposal, error) {
+	return s.taCalculator.ProposeForEvent(eventID)
+}
@@ -0,0 +1,122 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
+
+	"gorm.io/gorm"
+)
+
+// WageOverrideService manages individually negotiated wages. Every change
+// recomputes the user's current wage through the wage resolver.
+type WageOverrideService struct {
+	repo         interfaces.WageOverrideRepository
+	userRepo     interfaces.UserRepository
+	wageResolver *wages.WageResolver
+}
+
+func NewWageOverrideService(
+	repo interfaces.WageOverrideRepository,
+	userRepo interfaces.UserRepository,
+	wageResolver *wages.WageResolver,
+) *WageOverrideService {
+	return &WageOverrideService{
+		repo:         repo,
+		userRepo:     userRepo,
+		wageResolver: wageResolver,
+	}
+}
+
+func (s *WageOverrideService) GetOverride(userID uint) (*models.WageOverride, error) {
+	override, err := s.repo.FindByUser(userID)
+	if err != nil {
+		return nil, errors.New("user has no wage override")
+	}
+	return override, nil
+}
+
+// SetOverride puts a new override in force from today. An existing one is
+// ended today and kept, so bookings dated before still resolve to it.
+func (s *WageOverrideService) SetOverride(userID uint, input *models.WageOverride, adminID uint) (*models.WageOverride, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if !models.IsStaffRole(user.Role) {
+		return nil, errors.New("wage overrides apply to staff users only")
+	}
+
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	today := dateOnly(time.Now())
+	override := &models.WageOverride{
+		UserID:    userID,
+		Type:      input.Type,
+		Value:     input.Value,
+		StartsAt:  today,
+		ExpiresAt: input.ExpiresAt,
+		Reason:    input.Reason,
+		CreatedBy: &adminID,
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.ExpireTx(tx, userID, today); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err := s.repo.SaveTx(tx, override); err != nil {
+			return err
+		}
+		return s.wageResolver.SyncUsersTx(tx, []uint{userID}, models.WageChangeSourceOverride, override.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return override, nil
+}
+
+// RemoveOverride ends the user's override today. The row is kept for
+// bookings dated before.
+func (s *WageOverrideService) RemoveOverride(userID uint) error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.ExpireTx(tx, userID, dateOnly(time.Now())); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("user has no wage override")
+			}
+			return err
+		}
+		return s.wageResolver.SyncUsersTx(tx, []uint{userID}, models.WageChangeSourceOverride, "wage override removed")
+	})
+}
+
+// ReleaseExpired restores the computed wage of users whose override has
+// expired. The override row is kept for bookings dated before the expiry.
+// Called by the background scheduler.
+func (s *WageOverrideService) ReleaseExpired(now time.Time) (int, error) {
+	expired, err := s.repo.ListExpired(now)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, override := range expired {
+		reason := fmt.Sprintf("wage override expired on %s", override.ExpiresAt.Format("2006-01-02"))
+		if err := config.DB.Transaction(func(tx *gorm.DB) error {
+			if err := s.repo.MarkReleasedTx(tx, override.ID, now); err != nil {
+				return err
+			}
+			return s.wageResolver.SyncUsersTx(tx, []uint{override.UserID}, models.WageChangeSourceOverride, reason)
+		}); err != nil {
+			return released, err
+		}
+		released++
+	}
+	return released, nil
+}
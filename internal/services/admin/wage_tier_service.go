@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
+
+	"gorm.io/gorm"
+)
+
+// WageTierService manages the seniority tiers of each role. Every change
+// recomputes the current wage of the users of that role.
+type WageTierService struct {
+	repo         interfaces.WageTierRepository
+	wageResolver *wages.WageResolver
+}
+
+func NewWageTierService(
+	repo interfaces.WageTierRepository,
+	wageResolver *wages.WageResolver,
+) *WageTierService {
+	return &WageTierService{
+		repo:         repo,
+		wageResolver: wageResolver,
+	}
+}
+
+func (s *WageTierService) ListTiers(role string) ([]models.WageTier, error) {
+	if role != "" && !models.IsStaffRole(role) {
+		return nil, errors.New("invalid role")
+	}
+	return s.repo.ListAll(role)
+}
+
+func (s *WageTierService) CreateTier(tier *models.WageTier) error {
+	if err := s.checkUnique(tier); err != nil {
+		return err
+	}
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateTx(tx, tier); err != nil {
+			return err
+		}
+		return s.syncRole(tx, tier.Role)
+	})
+}
+
+func (s *WageTierService) UpdateTier(input *models.WageTier) error {
+	old, err := s.repo.FindByID(input.ID)
+	if err != nil {
+		return errors.New("wage tier not found")
+	}
+
+	if old.Role == input.Role &&
+		old.MinCompletedWork == input.MinCompletedWork &&
+		old.Increment == input.Increment {
+		return errors.New("no changes detected")
+	}
+
+	if err := s.checkUnique(input); err != nil {
+		return err
+	}
+
+	input.CreatedAt = old.CreatedAt
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.UpdateTx(tx, input); err != nil {
+			return err
+		}
+		if old.Role != input.Role {
+			if err := s.syncRole(tx, old.Role); err != nil {
+				return err
+			}
+		}
+		return s.syncRole(tx, input.Role)
+	})
+}
+
+func (s *WageTierService) DeleteTier(id uint) error {
+	tier, err := s.repo.FindByID(id)
+	if err != nil {
+		return errors.New("wage tier not found")
+	}
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.DeleteTx(tx, id); err != nil {
+			return err
+		}
+		return s.syncRole(tx, tier.Role)
+	})
+}
+
+// ---------------- HELPERS ----------------
+
+func (s *WageTierService) checkUnique(tier *models.WageTier) error {
+	tiers, err := s.repo.ListAll(tier.Role)
+	if err != nil {
+		return err
+	}
+	for _, t := range tiers {
+		if t.ID != tier.ID && t.MinCompletedWork == tier.MinCompletedWork {
+			return errors.New("a tier with this threshold already exists for the role")
+		}
+	}
+	return nil
+}
+
+func (s *WageTierService) syncRole(tx *gorm.DB, role string) error {
+	reason := fmt.Sprintf("%s wage tiers updated", models.RoleLabel(role))
+	return s.wageResolver.SyncRoleTx(tx, role, models.WageChangeSourceTier, reason)
+}
@@ -0,0 +1,142 @@
+package advances
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// Statement is a user's outstanding balance with their advances.
+type Statement struct {
+	Outstanding int64            `json:"outstanding"`
+	Advances    []models.Advance `json:"advances"`
+}
+
+// Ledger recovers advances from completed bookings.
+type Ledger struct {
+	repo interfaces.AdvanceRepository
+}
+
+func NewLedger(repo interfaces.AdvanceRepository) *Ledger {
+	return &Ledger{repo: repo}
+}
+
+// DeductTx takes the active advances of one user from their bookings in a
+// closing payroll period, oldest advance and earliest booking first. Each
+// booking's TotalAmount is lowered by what was taken and never goes below
+// zero, so event wage summaries report the deductions next to the net
+// total. The bookings are updated in place and saved.
+func (l *Ledger) DeductTx(tx *gorm.DB, periodID, userID uint, bookings []models.Booking) error {
+	advances, err := l.repo.ListActiveForUpdate(tx, userID)
+	if err != nil || len(advances) == 0 {
+		return err
+	}
+
+	repayments := deduct(periodID, userID, advances, bookings)
+
+	takenInPeriod := make(map[uint]int64, len(advances))
+	for i := range repayments {
+		if err := l.repo.CreateRepaymentTx(tx, &repayments[i]); err != nil {
+			return err
+		}
+		takenInPeriod[repayments[i].AdvanceID] += repayments[i].Amount
+	}
+
+	now := time.Now()
+	for j := range advances {
+		a := &advances[j]
+		if takenInPeriod[a.ID] == 0 {
+			continue
+		}
+		if a.Balance == 0 {
+			a.Status = models.AdvanceStatusSettled
+			a.SettledAt = &now
+		}
+		if err := l.repo.UpdateTx(tx, a); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range bookings {
+		if b.AdvanceDeduction == 0 {
+			continue
+		}
+		if err := tx.Model(&models.Booking{}).
+			Where("id = ?", b.ID).
+			Updates(map[string]interface{}{
+				"advance_deduction": b.AdvanceDeduction,
+				"total_amount":      b.TotalAmount,
+			}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deduct takes the advances from the bookings in place and returns the
+// repayments to record. Each advance gives at most its balance, PerEventCap
+// per booking and InstallmentAmount over all the bookings.
+func deduct(periodID, userID uint, advances []models.Advance, bookings []models.Booking) []models.AdvanceRepayment {
+	var repayments []models.AdvanceRepayment
+	takenInPeriod := make(map[uint]int64, len(advances))
+
+	for i := range bookings {
+		b := &bookings[i]
+
+		for j := range advances {
+			a := &advances[j]
+			if b.TotalAmount <= 0 {
+				break
+			}
+			if a.Balance <= 0 {
+				continue
+			}
+
+			amount := min(a.Balance, b.TotalAmount)
+			if a.PerEventCap > 0 {
+				amount = min(amount, a.PerEventCap)
+			}
+			if a.InstallmentAmount > 0 {
+				amount = min(amount, a.InstallmentAmount-takenInPeriod[a.ID])
+			}
+			if amount <= 0 {
+				continue
+			}
+
+			bookingID := b.ID
+			repayments = append(repayments, models.AdvanceRepayment{
+				AdvanceID: a.ID,
+				UserID:    userID,
+				BookingID: &bookingID,
+				PeriodID:  &periodID,
+				Source:    models.RepaymentSourceDeduction,
+				Amount:    amount,
+			})
+
+			takenInPeriod[a.ID] += amount
+			a.Balance -= amount
+			b.AdvanceDeduction += amount
+			b.TotalAmount -= amount
+		}
+	}
+	return repayments
+}
+
+// StatementFor returns the outstanding balance and every advance of a user.
+func (l *Ledger) StatementFor(userID uint) (*Statement, error) {
+	advances, err := l.repo.List(userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	outstanding, err := l.repo.Outstanding(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Statement{Outstanding: outstanding, Advances: advances}, nil
+}
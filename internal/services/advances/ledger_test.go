@@ -0,0 +1,134 @@
+package advances
+
+import (
+	"testing"
+
+	"event-management-backend/internal/domain/models"
+)
+
+type repaid struct {
+	advance uint
+	booking uint
+	amount  int64
+}
+
+func TestDeduct(t *testing.T) {
+	tests := []struct {
+		name         string
+		advances     []models.Advance
+		totals       []int64
+		want         []repaid
+		wantTotals   []int64
+		wantBalances []int64
+	}{
+		{
+			name:         "balance below the booking total",
+			advances:     []models.Advance{{ID: 1, Balance: 300}},
+			totals:       []int64{1000},
+			want:         []repaid{{1, 1, 300}},
+			wantTotals:   []int64{700},
+			wantBalances: []int64{0},
+		},
+		{
+			name:         "booking total never goes below zero",
+			advances:     []models.Advance{{ID: 1, Balance: 1500}},
+			totals:       []int64{1000, 400},
+			want:         []repaid{{1, 1, 1000}, {1, 2, 400}},
+			wantTotals:   []int64{0, 0},
+			wantBalances: []int64{100},
+		},
+		{
+			name:         "per event cap applies to each booking",
+			advances:     []models.Advance{{ID: 1, Balance: 1000, PerEventCap: 200}},
+			totals:       []int64{1000, 1000},
+			want:         []repaid{{1, 1, 200}, {1, 2, 200}},
+			wantTotals:   []int64{800, 800},
+			wantBalances: []int64{600},
+		},
+		{
+			name:         "installment caps the whole period",
+			advances:     []models.Advance{{ID: 1, Balance: 1000, InstallmentAmount: 250}},
+			totals:       []int64{1000, 1000},
+			want:         []repaid{{1, 1, 250}},
+			wantTotals:   []int64{750, 1000},
+			wantBalances: []int64{750},
+		},
+		{
+			name:         "per event cap within the installment",
+			advances:     []models.Advance{{ID: 1, Balance: 1000, PerEventCap: 200, InstallmentAmount: 300}},
+			totals:       []int64{1000, 1000, 1000},
+			want:         []repaid{{1, 1, 200}, {1, 2, 100}},
+			wantTotals:   []int64{800, 900, 1000},
+			wantBalances: []int64{700},
+		},
+		{
+			name: "oldest advance is taken first",
+			advances: []models.Advance{
+				{ID: 1, Balance: 300},
+				{ID: 2, Balance: 500},
+			},
+			totals:       []int64{600},
+			want:         []repaid{{1, 1, 300}, {2, 1, 300}},
+			wantTotals:   []int64{0},
+			wantBalances: []int64{0, 200},
+		},
+		{
+			name:         "booking without pay is skipped",
+			advances:     []models.Advance{{ID: 1, Balance: 300}},
+			totals:       []int64{0, 500},
+			want:         []repaid{{1, 2, 300}},
+			wantTotals:   []int64{0, 200},
+			wantBalances: []int64{0},
+		},
+		{
+			name: "advance without balance is skipped",
+			advances: []models.Advance{
+				{ID: 1, Balance: 0},
+				{ID: 2, Balance: 100},
+			},
+			totals:       []int64{500},
+			want:         []repaid{{2, 1, 100}},
+			wantTotals:   []int64{400},
+			wantBalances: []int64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookings := make([]models.Booking, len(tt.totals))
+			for i, total := range tt.totals {
+				bookings[i] = models.Booking{ID: uint(i + 1), TotalAmount: total}
+			}
+
+			got := deduct(7, 3, tt.advances, bookings)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d repayments, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, r := range got {
+				w := tt.want[i]
+				if r.AdvanceID != w.advance || *r.BookingID != w.booking || r.Amount != w.amount {
+					t.Errorf("repayment %d = advance %d booking %d amount %d, want %+v",
+						i, r.AdvanceID, *r.BookingID, r.Amount, w)
+				}
+				if r.UserID != 3 || *r.PeriodID != 7 || r.Source != models.RepaymentSourceDeduction {
+					t.Errorf("repayment %d = %+v, want user 3 period 7 from a deduction", i, r)
+				}
+			}
+
+			for i, b := range bookings {
+				if b.TotalAmount != tt.wantTotals[i] {
+					t.Errorf("booking %d total = %d, want %d", b.ID, b.TotalAmount, tt.wantTotals[i])
+				}
+				if b.AdvanceDeduction != tt.totals[i]-tt.wantTotals[i] {
+					t.Errorf("booking %d deduction = %d, want %d", b.ID, b.AdvanceDeduction, tt.totals[i]-tt.wantTotals[i])
+				}
+			}
+			for i, a := range tt.advances {
+				if a.Balance != tt.wantBalances[i] {
+					t.Errorf("advance %d balance = %d, want %d", a.ID, a.Balance, tt.wantBalances[i])
+				}
+			}
+		})
+	}
+}
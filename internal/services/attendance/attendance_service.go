@@ -0,0 +1,306 @@
+package attendance
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultTokenTTLMinutes = 5
+	defaultRadiusMeters    = 200
+	qrImageSize            = 320
+
+	// scans closer together than this are treated as a double scan
+	minCheckOutGap = 2 * time.Minute
+
+	ScanCheckedIn  = "checked_in"
+	ScanCheckedOut = "checked_out"
+)
+
+// AttendanceService issues signed check-in QR codes to booked users and
+// records the scans made by the event captain.
+type AttendanceService struct {
+	bookingRepo  interfaces.BookingRepository
+	eventRepo    interfaces.EventRepository
+	settingRepo  interfaces.SettingRepository
+	attemptRepo  interfaces.CheckInAttemptRepository
+	wageResolver *wages.WageResolver
+	taCalculator *travel.TACalculator
+}
+
+func NewAttendanceService(
+	bookingRepo interfaces.BookingRepository,
+	eventRepo interfaces.EventRepository,
+	settingRepo interfaces.SettingRepository,
+	attemptRepo interfaces.CheckInAttemptRepository,
+	wageResolver *wages.WageResolver,
+	taCalculator *travel.TACalculator,
+) *AttendanceService {
+	return &AttendanceService{
+		bookingRepo:  bookingRepo,
+		eventRepo:    eventRepo,
+		settingRepo:  settingRepo,
+		attemptRepo:  attemptRepo,
+		wageResolver: wageResolver,
+		taCalculator: taCalculator,
+	}
+}
+
+type ScanResult struct {
+	BookingID    uint       `json:"booking_id"`
+	UserID       uint       `json:"user_id"`
+	Action       string     `json:"action"`
+	Status       string     `json:"status"`
+	CheckedInAt  *time.Time `json:"checked_in_at"`
+	CheckedOutAt *time.Time `json:"checked_out_at"`
+}
+
+// ---------------- ISSUE QR ----------------
+
+// CheckInQRCode returns a PNG QR code for the user's own booking.
+func (s *AttendanceService) CheckInQRCode(userID, bookingID uint) ([]byte, time.Time, error) {
+	booking, err := s.bookingRepo.FindByID(bookingID)
+	if err != nil || booking.UserID != userID {
+		return nil, time.Time{}, errors.New("booking not found")
+	}
+
+	event, err := s.eventRepo.FindByID(booking.EventID)
+	if err != nil {
+		return nil, time.Time{}, errors.New("event not found")
+	}
+	if event.Status == models.EventStatusCompleted ||
+		event.Status == models.EventStatusCancelled {
+		return nil, time.Time{}, errors.New("event is no longer active")
+	}
+	if booking.CheckedOutAt != nil {
+		return nil, time.Time{}, errors.New("already checked out")
+	}
+
+	ttl := s.settingRepo.GetInt(models.SettingCheckInTokenTTLMinutes, defaultTokenTTLMinutes)
+	if ttl <= 0 {
+		ttl = defaultTokenTTLMinutes
+	}
+
+	token, expires, err := utils.SignCheckInToken(booking.ID, time.Duration(ttl)*time.Minute)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	png, err := utils.QRCodePNG(token, qrImageSize)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return png, expires, nil
+}
+
+// ---------------- SCAN ----------------
+
+// Scan checks a booking in on the first scan and out on the next one.
+// Only the captain of an ongoing event may scan.
+func (s *AttendanceService) Scan(captainID uint, token string) (*ScanResult, error) {
+	bookingID, err := utils.VerifyCheckInToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *ScanResult
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		booking, err := s.bookingRepo.FindByIDForUpdate(tx, bookingID)
+		if err != nil {
+			return errors.New("booking not found")
+		}
+
+		var event models.Event
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", booking.EventID).
+			First(&event).Error; err != nil {
+			return errors.New("event not found")
+		}
+
+		if event.Status != models.EventStatusOngoing {
+			return errors.New("check-in is only allowed for ongoing events")
+		}
+
+		var count int64
+		if err := tx.Model(&models.Booking{}).
+			Where("event_id = ? AND user_id = ? AND role = ? AND deleted_at IS NULL",
+				event.ID, captainID, models.RoleCaptain).
+			Count(&count).Error; err != nil || count == 0 {
+			return errors.New("you are not authorized to record attendance for this event")
+		}
+
+		now := time.Now()
+		action := ScanCheckedIn
+
+		switch {
+		case booking.CheckedInAt == nil:
+			booking.CheckedInAt = &now
+			booking.CheckInMethod = models.CheckInMethodQR
+
+			if booking.Status != models.BookingStatusPresent {
+				if err := s.markPresent(tx, booking, &event); err != nil {
+					return err
+				}
+			}
+
+		case booking.CheckedOutAt == nil:
+			if now.Sub(*booking.CheckedInAt) < minCheckOutGap {
+				return errors.New("already checked in")
+			}
+			booking.CheckedOutAt = &now
+			action = ScanCheckedOut
+
+		default:
+			return errors.New("already checked out")
+		}
+
+		if err := tx.Omit("Event").Save(booking).Error; err != nil {
+			return err
+		}
+
+		result = &ScanResult{
+			BookingID:    booking.ID,
+			UserID:       booking.UserID,
+			Action:       action,
+			Status:       booking.Status,
+			CheckedInAt:  booking.CheckedInAt,
+			CheckedOutAt: booking.CheckedOutAt,
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// ---------------- GEOFENCED SELF CHECK-IN ----------------
+
+// SelfCheckIn checks the user's own booking in when the device is within
+// the event radius. Every attempt is logged, including rejected ones.
+func (s *AttendanceService) SelfCheckIn(userID, bookingID uint, lat, lng float64) (*ScanResult, error) {
+	booking, err := s.bookingRepo.FindByID(bookingID)
+	if err != nil || booking.UserID != userID {
+		return nil, errors.New("booking not found")
+	}
+
+	event, err := s.eventRepo.FindByID(booking.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	if event.Status != models.EventStatusOngoing {
+		return nil, errors.New("check-in is only allowed for ongoing events")
+	}
+	if !event.HasLocation() {
+		return nil, errors.New("event location is not set for self check-in")
+	}
+
+	radius := event.RadiusMeters
+	if radius == 0 {
+		radius = uint(s.settingRepo.GetInt(models.SettingCheckInRadiusMeters, defaultRadiusMeters))
+	}
+
+	distance := utils.DistanceMeters(lat, lng, *event.Latitude, *event.Longitude)
+
+	attempt := &models.CheckInAttempt{
+		BookingID:      booking.ID,
+		EventID:        event.ID,
+		UserID:         userID,
+		Latitude:       lat,
+		Longitude:      lng,
+		DistanceMeters: math.Round(distance),
+		RadiusMeters:   radius,
+	}
+
+	if distance > float64(radius) {
+		attempt.Reason = "out of range"
+		s.logAttempt(attempt)
+		return nil, fmt.Errorf("you are %.0f m from the venue, check-in allowed within %d m", distance, radius)
+	}
+
+	var result *ScanResult
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		locked, err := s.bookingRepo.FindByIDForUpdate(tx, bookingID)
+		if err != nil {
+			return errors.New("booking not found")
+		}
+		if locked.CheckedInAt != nil {
+			return errors.New("already checked in")
+		}
+
+		now := time.Now()
+		locked.CheckedInAt = &now
+		locked.CheckInMethod = models.CheckInMethodGeo
+
+		if locked.Status != models.BookingStatusPresent {
+			if err := s.markPresent(tx, locked, event); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Omit("Event").Save(locked).Error; err != nil {
+			return err
+		}
+
+		result = &ScanResult{
+			BookingID:   locked.ID,
+			UserID:      locked.UserID,
+			Action:      ScanCheckedIn,
+			Status:      locked.Status,
+			CheckedInAt: locked.CheckedInAt,
+		}
+		return nil
+	})
+	if err != nil {
+		attempt.Reason = err.Error()
+		s.logAttempt(attempt)
+		return nil, err
+	}
+
+	attempt.Accepted = true
+	s.logAttempt(attempt)
+	return result, nil
+}
+
+func (s *AttendanceService) logAttempt(attempt *models.CheckInAttempt) {
+	if err := s.attemptRepo.Create(attempt); err != nil {
+		log.Printf("attendance: failed to log check-in attempt for booking %d: %v", attempt.BookingID, err)
+	}
+}
+
+// markPresent sets the booking present and fills in amounts the same way
+// manual attendance does, keeping any TA, bonus or fine already entered.
+func (s *AttendanceService) markPresent(tx *gorm.DB, booking *models.Booking, event *models.Event) error {
+	var user models.User
+	if err := tx.Where("id = ? AND deleted_at IS NULL", booking.UserID).
+		First(&user).Error; err != nil {
+		return errors.New("worker user not found")
+	}
+
+	booking.Status = models.BookingStatusPresent
+	booking.BaseAmount = s.wageResolver.BaseWage(tx, &user, booking.Role, event.Date)
+	s.taCalculator.ApplyTx(tx, booking, &user, event, nil)
+
+	if event.LongWork {
+		booking.ExtraAmount = event.ExtraWageAmount
+	} else {
+		booking.ExtraAmount = 0
+	}
+
+	booking.RecalcTotal()
+	return nil
+}
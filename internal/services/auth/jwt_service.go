@@ -32,10 +32,14 @@ type Claims struct {
 	UserID uint   `json:"user_id"`
 	Role   string `json:"role"`
 	Permissions []string `json:"permissions"`
+	// SessionID is the refresh token family the token was issued for.
+	SessionID uint `json:"sid"`
+	// TokenVersion must match User.TokenVersion for the token to be accepted.
+	TokenVersion uint `json:"tv"`
 	jwt.RegisteredClaims
 }
 
-func (j *JWTService) GenerateAccessToken(userID uint, role string, permissions []string) (string, error) {
+func (j *JWTService) GenerateAccessToken(userID uint, role string, permissions []string, sessionID, tokenVersion uint) (string, error) {
     if j.accessSecret == "" {
         return "", errors.New("jwt access secret missing")
     }
@@ -44,6 +48,8 @@ func (j *JWTService) GenerateAccessToken(userID uint, role string, permissions [
         UserID:      userID,
         Role:        role,
         Permissions: permissions,
+        SessionID:   sessionID,
+        TokenVersion: tokenVersion,
         RegisteredClaims: jwt.RegisteredClaims{
             ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(AccessTTL)),
             IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
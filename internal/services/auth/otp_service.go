@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/sms"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	OTPLength         = 6
+	OTPTTL            = 5 * time.Minute
+	OTPMaxAttempts    = 5
+	OTPResendInterval = time.Minute
+	OTPMaxPerHour     = 5
+	// per IP, so one client cannot walk through many numbers; only
+	// applied when config.LimitByIP is on
+	OTPMaxPerHourPerIP = 30
+)
+
+var (
+	ErrOTPInvalid     = errors.New("invalid or expired otp")
+	ErrOTPAttempts    = errors.New("too many wrong attempts, request a new otp")
+	ErrOTPTooSoon     = errors.New("please wait a minute before requesting another otp")
+	ErrOTPHourlyLimit = errors.New("too many otp requests, try again later")
+)
+
+type OTPService struct {
+	userRepo    interfaces.UserRepository
+	otpRepo     interfaces.OTPRepository
+	requestRepo interfaces.AuthRequestRepository
+	sender      sms.SMSSender
+}
+
+func NewOTPService(
+	userRepo interfaces.UserRepository,
+	otpRepo interfaces.OTPRepository,
+	requestRepo interfaces.AuthRequestRepository,
+	sender sms.SMSSender,
+) *OTPService {
+	return &OTPService{
+		userRepo:    userRepo,
+		otpRepo:     otpRepo,
+		requestRepo: requestRepo,
+		sender:      sender,
+	}
+}
+
+// Request sends a login code. Unknown and blocked numbers get no SMS but the
+// same response, and the limits are applied before the phone is looked up,
+// so the endpoint cannot be used to find registered phones.
+func (s *OTPService) Request(phone, ip string) error {
+	now := time.Now().UTC()
+	if err := s.throttle(phone, ip, now); err != nil {
+		return err
+	}
+	if err := s.requestRepo.Create(&models.AuthRequest{
+		Kind:  models.AuthRequestOTP,
+		Phone: phone,
+		IP:    ip,
+	}); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByPhone(phone)
+	if err != nil || user.Status == models.StatusBlocked {
+		return nil
+	}
+
+	code, err := generateOTP()
+	if err != nil {
+		return err
+	}
+
+	if err := s.otpRepo.Create(&models.OTPCode{
+		Phone:      phone,
+		CodeHashed: hashOTP(phone, code),
+		ExpiresAt:  now.Add(OTPTTL),
+		IP:         ip,
+	}); err != nil {
+		return err
+	}
+
+	return s.sender.Send(phone, fmt.Sprintf(
+		"%s is your login code. It expires in %d minutes. Do not share it.",
+		code, int(OTPTTL.Minutes()),
+	))
+}
+
+// Verify consumes the code and returns the user it was sent to. Every wrong
+// guess counts against the code; after OTPMaxAttempts it is retired.
+func (s *OTPService) Verify(phone, code string) (*models.User, error) {
+	var verifyErr error
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		otp, err := s.otpRepo.FindActiveForUpdateTx(tx, phone)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				verifyErr = ErrOTPInvalid
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		if otp.ExpiresAt.Before(now) {
+			verifyErr = ErrOTPInvalid
+			return nil
+		}
+
+		if !hmac.Equal([]byte(otp.CodeHashed), []byte(hashOTP(phone, code))) {
+			// saved, not rolled back, so guesses really are limited
+			otp.Attempts++
+			verifyErr = ErrOTPInvalid
+			if otp.Attempts >= OTPMaxAttempts {
+				otp.ConsumedAt = &now
+				verifyErr = ErrOTPAttempts
+			}
+			return s.otpRepo.UpdateTx(tx, otp)
+		}
+
+		otp.ConsumedAt = &now
+		return s.otpRepo.UpdateTx(tx, otp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if verifyErr != nil {
+		return nil, verifyErr
+	}
+
+	user, err := s.userRepo.FindByPhone(phone)
+	if err != nil {
+		return nil, ErrOTPInvalid
+	}
+	return user, nil
+}
+
+// throttle checks the resend interval and hourly limits of the phone and
+// the IP. Rejected requests are not recorded.
+func (s *OTPService) throttle(phone, ip string, now time.Time) error {
+	last, err := s.requestRepo.LatestByPhone(models.AuthRequestOTP, phone)
+	if err != nil {
+		return err
+	}
+	if last != nil && now.Sub(*last) < OTPResendInterval {
+		return ErrOTPTooSoon
+	}
+
+	since := now.Add(-time.Hour)
+	sent, err := s.requestRepo.CountByPhoneSince(models.AuthRequestOTP, phone, since)
+	if err != nil {
+		return err
+	}
+	if sent >= OTPMaxPerHour {
+		return ErrOTPHourlyLimit
+	}
+
+	if ip == "" || !config.LimitByIP() {
+		return nil
+	}
+	sent, err = s.requestRepo.CountByIPSince(models.AuthRequestOTP, ip, since)
+	if err != nil {
+		return err
+	}
+	if sent >= OTPMaxPerHourPerIP {
+		return ErrOTPHourlyLimit
+	}
+	return nil
+}
+
+func generateOTP() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < OTPLength; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", OTPLength, n.Int64()), nil
+}
+
+// hashOTP binds the code to the phone so equal codes hash differently.
+func hashOTP(phone, code string) string {
+	return utils.HashToken(phone + ":" + code)
+}
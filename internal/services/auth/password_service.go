@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/notify"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PasswordResetTTL        = 30 * time.Minute
+	PasswordResetMaxPerHour = 3
+	// per IP, so one client cannot walk through many numbers; only
+	// applied when config.LimitByIP is on
+	PasswordResetMaxPerHourPerIP = 10
+	passwordResetTokenLength     = 32
+)
+
+var (
+	ErrWrongPassword      = errors.New("current password is incorrect")
+	ErrResetTokenInvalid  = errors.New("invalid or expired reset token")
+	ErrResetLimitExceeded = errors.New("too many reset requests, try again later")
+)
+
+type PasswordService struct {
+	userRepo    interfaces.UserRepository
+	refreshRepo interfaces.RefreshTokenRepository
+	resetRepo   interfaces.PasswordResetRepository
+	requestRepo interfaces.AuthRequestRepository
+	notifier    notify.Notifier
+}
+
+func NewPasswordService(
+	userRepo interfaces.UserRepository,
+	refreshRepo interfaces.RefreshTokenRepository,
+	resetRepo interfaces.PasswordResetRepository,
+	requestRepo interfaces.AuthRequestRepository,
+	notifier notify.Notifier,
+) *PasswordService {
+	return &PasswordService{
+		userRepo:    userRepo,
+		refreshRepo: refreshRepo,
+		resetRepo:   resetRepo,
+		requestRepo: requestRepo,
+		notifier:    notifier,
+	}
+}
+
+// Change sets a new password for a logged-in user. Every other session is
+// logged out; the caller's session (sessionID) is kept.
+func (s *PasswordService) Change(userID, sessionID uint, current, next string) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+	if !utils.CheckPasswordHash(current, user.Password) {
+		return ErrWrongPassword
+	}
+	if err := utils.CheckPasswordStrength(next, user.Phone); err != nil {
+		return err
+	}
+
+	hashed, err := utils.HashPassword(next)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.SetPasswordTx(config.DB, userID, hashed); err != nil {
+		return err
+	}
+
+	return s.refreshRepo.DeleteOtherFamilies(userID, sessionID)
+}
+
+// Forgot sends a reset token to the user. Unknown and blocked numbers get no
+// message and no error. The limits are applied before the phone is looked
+// up, so hitting them says nothing about whether it is registered.
+func (s *PasswordService) Forgot(phone, ip string) error {
+	now := time.Now().UTC()
+	if err := s.throttle(phone, ip, now); err != nil {
+		return err
+	}
+	if err := s.requestRepo.Create(&models.AuthRequest{
+		Kind:  models.AuthRequestPasswordReset,
+		Phone: phone,
+		IP:    ip,
+	}); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.FindByPhone(phone)
+	if err != nil || user.Status == models.StatusBlocked {
+		return nil
+	}
+
+	raw, err := generateResetToken()
+	if err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.Create(&models.PasswordResetToken{
+		UserID:      user.ID,
+		TokenHashed: utils.HashToken(raw),
+		ExpiresAt:   now.Add(PasswordResetTTL),
+		IP:          ip,
+	}); err != nil {
+		return err
+	}
+
+	return s.notifier.Notify(user, resetMessage(raw))
+}
+
+// Reset sets the password from a forgot-password token. The token is used
+// up and all sessions of the user end.
+func (s *PasswordService) Reset(raw, next string) error {
+	var userID uint
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		token, err := s.resetRepo.FindByHashedForUpdateTx(tx, utils.HashToken(raw))
+		if err != nil {
+			return ErrResetTokenInvalid
+		}
+		if token.UsedAt != nil || token.ExpiresAt.Before(time.Now().UTC()) {
+			return ErrResetTokenInvalid
+		}
+
+		user, err := s.userRepo.FindByID(token.UserID)
+		if err != nil || user.Status == models.StatusBlocked {
+			return ErrResetTokenInvalid
+		}
+		if err := utils.CheckPasswordStrength(next, user.Phone); err != nil {
+			return err
+		}
+
+		hashed, err := utils.HashPassword(next)
+		if err != nil {
+			return err
+		}
+		if err := s.userRepo.SetPasswordTx(tx, user.ID, hashed); err != nil {
+			return err
+		}
+
+		userID = user.ID
+		return s.resetRepo.MarkUsedTx(tx, token.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.refreshRepo.DeleteByUserID(userID)
+}
+
+func generateResetToken() (string, error) {
+	b := make([]byte, passwordResetTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// resetMessage links to PASSWORD_RESET_URL when set, otherwise sends the
+// bare token for the app to submit.
+func resetMessage(token string) string {
+	minutes := int(PasswordResetTTL.Minutes())
+	if base := os.Getenv("PASSWORD_RESET_URL"); base != "" {
+		return fmt.Sprintf("Reset your password: %s?token=%s (valid for %d minutes)", base, token, minutes)
+	}
+	return fmt.Sprintf("Your password reset token is %s (valid for %d minutes)", token, minutes)
+}
+
+// throttle checks the hourly limits of the phone and the IP. Rejected
+// requests are not recorded.
+func (s *PasswordService) throttle(phone, ip string, now time.Time) error {
+	since := now.Add(-time.Hour)
+	sent, err := s.requestRepo.CountByPhoneSince(models.AuthRequestPasswordReset, phone, since)
+	if err != nil {
+		return err
+	}
+	if sent >= PasswordResetMaxPerHour {
+		return ErrResetLimitExceeded
+	}
+
+	if ip == "" || !config.LimitByIP() {
+		return nil
+	}
+	sent, err = s.requestRepo.CountByIPSince(models.AuthRequestPasswordReset, ip, since)
+	if err != nil {
+		return err
+	}
+	if sent >= PasswordResetMaxPerHourPerIP {
+		return ErrResetLimitExceeded
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// RotationGrace lets a second request carrying the token that was just
+// rotated (two tabs refreshing at once) fail softly instead of being
+// treated as reuse.
+const RotationGrace = 10 * time.Second
+
+var (
+	ErrRefreshInvalid = errors.New("invalid refresh token")
+	ErrRefreshExpired = errors.New("session expired")
+	ErrRefreshReused  = errors.New("refresh token reuse detected")
+	ErrRefreshRaced   = errors.New("refresh token already rotated")
+)
+
+// Device describes where a session was started or last refreshed from.
+type Device struct {
+	Name      string
+	UserAgent string
+	IP        string
+}
+
+type SessionService struct {
+	refreshRepo  interfaces.RefreshTokenRepository
+	securityRepo interfaces.SecurityEventRepository
+	jwtService   *JWTService
+}
+
+func NewSessionService(
+	refreshRepo interfaces.RefreshTokenRepository,
+	securityRepo interfaces.SecurityEventRepository,
+	jwtService *JWTService,
+) *SessionService {
+	return &SessionService{
+		refreshRepo:  refreshRepo,
+		securityRepo: securityRepo,
+		jwtService:   jwtService,
+	}
+}
+
+// Start opens a new session family and returns its raw refresh token.
+func (s *SessionService) Start(userID uint, device Device) (string, *models.RefreshToken, error) {
+	raw, hashed, expiresAt, err := s.jwtService.GenerateRefreshToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now().UTC()
+	session := &models.RefreshToken{
+		UserID:      userID,
+		TokenHashed: hashed,
+		ExpiresAt:   expiresAt,
+		DeviceName:  device.Name,
+		UserAgent:   device.UserAgent,
+		IP:          device.IP,
+		LastUsedAt:  &now,
+	}
+	if err := s.refreshRepo.Save(session); err != nil {
+		return "", nil, err
+	}
+	return raw, session, nil
+}
+
+// Rotate exchanges a refresh token for a new one in the same family. A token
+// that was already exchanged revokes the whole family.
+func (s *SessionService) Rotate(raw string, device Device) (string, *models.RefreshToken, error) {
+	if raw == "" {
+		return "", nil, ErrRefreshInvalid
+	}
+
+	newRaw, hashed, expiresAt, err := s.jwtService.GenerateRefreshToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var next *models.RefreshToken
+	var reused *models.RefreshToken
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		current, err := s.refreshRepo.FindByHashedTokenForUpdateTx(tx, utils.HashToken(raw))
+		if err != nil {
+			return ErrRefreshInvalid
+		}
+
+		now := time.Now().UTC()
+		if current.RotatedAt != nil {
+			if now.Sub(*current.RotatedAt) < RotationGrace {
+				return ErrRefreshRaced
+			}
+			reused = current
+			return ErrRefreshReused
+		}
+		if current.ExpiresAt.Before(now) {
+			return ErrRefreshExpired
+		}
+
+		next = &models.RefreshToken{
+			FamilyID:    current.FamilyID,
+			UserID:      current.UserID,
+			TokenHashed: hashed,
+			ExpiresAt:   expiresAt,
+			DeviceName:  current.DeviceName,
+			UserAgent:   device.UserAgent,
+			IP:          device.IP,
+			LastUsedAt:  &now,
+		}
+		return s.refreshRepo.RotateTx(tx, current, next)
+	})
+
+	// revoked outside the transaction so the rollback does not undo it
+	if reused != nil {
+		s.revokeReusedFamily(reused, device)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return newRaw, next, nil
+}
+
+func (s *SessionService) revokeReusedFamily(token *models.RefreshToken, device Device) {
+	if err := s.refreshRepo.DeleteFamily(token.UserID, token.FamilyID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("auth: failed to revoke session %d of user %d: %v", token.FamilyID, token.UserID, err)
+	}
+
+	log.Printf("auth: refresh token reuse for user %d, session %d revoked", token.UserID, token.FamilyID)
+	if err := s.securityRepo.Create(&models.SecurityEvent{
+		UserID:    token.UserID,
+		Kind:      models.SecurityEventRefreshReuse,
+		SessionID: token.FamilyID,
+		IP:        device.IP,
+		UserAgent: device.UserAgent,
+		Detail:    "retired refresh token presented, session revoked",
+	}); err != nil {
+		log.Printf("auth: failed to record security event for user %d: %v", token.UserID, err)
+	}
+}
@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// txOnlyDriver accepts transactions and nothing else, which is all Rotate
+// needs from config.DB when the repositories are faked.
+type txOnlyDriver struct{}
+type txOnlyConn struct{}
+
+func (txOnlyDriver) Open(string) (driver.Conn, error) { return txOnlyConn{}, nil }
+
+func (txOnlyConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("no queries expected")
+}
+func (txOnlyConn) Close() error              { return nil }
+func (txOnlyConn) Begin() (driver.Tx, error) { return txOnlyConn{}, nil }
+func (txOnlyConn) Commit() error             { return nil }
+func (txOnlyConn) Rollback() error           { return nil }
+
+func init() {
+	sql.Register("txonly", txOnlyDriver{})
+}
+
+func useTxOnlyDB(t *testing.T) {
+	t.Helper()
+	sqlDB, err := sql.Open("txonly", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := config.DB
+	config.DB = db
+	t.Cleanup(func() { config.DB = prev })
+}
+
+type fakeRefreshRepo struct {
+	interfaces.RefreshTokenRepository
+	tokens        map[string]*models.RefreshToken
+	rotated       []*models.RefreshToken
+	deletedFamily []uint
+}
+
+func (f *fakeRefreshRepo) FindByHashedTokenForUpdateTx(tx *gorm.DB, hashed string) (*models.RefreshToken, error) {
+	if token, ok := f.tokens[hashed]; ok {
+		return token, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (f *fakeRefreshRepo) RotateTx(tx *gorm.DB, old, next *models.RefreshToken) error {
+	now := time.Now().UTC()
+	old.RotatedAt = &now
+	f.tokens[next.TokenHashed] = next
+	f.rotated = append(f.rotated, next)
+	return nil
+}
+
+func (f *fakeRefreshRepo) DeleteFamily(userID, familyID uint) error {
+	f.deletedFamily = append(f.deletedFamily, familyID)
+	return nil
+}
+
+type fakeSecurityRepo struct {
+	interfaces.SecurityEventRepository
+	events []models.SecurityEvent
+}
+
+func (f *fakeSecurityRepo) Create(event *models.SecurityEvent) error {
+	f.events = append(f.events, *event)
+	return nil
+}
+
+func TestSessionServiceRotate(t *testing.T) {
+	useTxOnlyDB(t)
+
+	const raw = "presented-refresh-token"
+	now := time.Now().UTC()
+	justNow := now.Add(-RotationGrace / 2)
+	longAgo := now.Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		raw        string
+		stored     *models.RefreshToken
+		wantErr    error
+		wantRevoke bool
+	}{
+		{
+			name:    "empty token",
+			raw:     "",
+			wantErr: ErrRefreshInvalid,
+		},
+		{
+			name:    "unknown token",
+			raw:     raw,
+			wantErr: ErrRefreshInvalid,
+		},
+		{
+			name:   "live token is exchanged",
+			raw:    raw,
+			stored: &models.RefreshToken{ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:    "expired token",
+			raw:     raw,
+			stored:  &models.RefreshToken{ExpiresAt: now.Add(-time.Minute)},
+			wantErr: ErrRefreshExpired,
+		},
+		{
+			name:    "token rotated within the grace period",
+			raw:     raw,
+			stored:  &models.RefreshToken{ExpiresAt: now.Add(time.Hour), RotatedAt: &justNow},
+			wantErr: ErrRefreshRaced,
+		},
+		{
+			name:       "token rotated earlier is reuse",
+			raw:        raw,
+			stored:     &models.RefreshToken{ExpiresAt: now.Add(time.Hour), RotatedAt: &longAgo},
+			wantErr:    ErrRefreshReused,
+			wantRevoke: true,
+		},
+		{
+			name:       "reuse is caught even after expiry",
+			raw:        raw,
+			stored:     &models.RefreshToken{ExpiresAt: now.Add(-time.Minute), RotatedAt: &longAgo},
+			wantErr:    ErrRefreshReused,
+			wantRevoke: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshRepo := &fakeRefreshRepo{tokens: map[string]*models.RefreshToken{}}
+			if tt.stored != nil {
+				stored := *tt.stored
+				stored.ID = 11
+				stored.FamilyID = 4
+				stored.UserID = 7
+				stored.DeviceName = "phone"
+				stored.TokenHashed = utils.HashToken(raw)
+				refreshRepo.tokens[stored.TokenHashed] = &stored
+			}
+			securityRepo := &fakeSecurityRepo{}
+			s := NewSessionService(refreshRepo, securityRepo, NewJWTService())
+			device := Device{UserAgent: "test-agent", IP: "198.51.100.2"}
+
+			newRaw, next, err := s.Rotate(tt.raw, device)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rotate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil {
+				if newRaw == "" || newRaw == raw || next == nil {
+					t.Fatalf("Rotate() = %q, %v; want a new token", newRaw, next)
+				}
+				if next.FamilyID != 4 || next.UserID != 7 || next.DeviceName != "phone" || next.IP != device.IP {
+					t.Errorf("new token = %+v, want it in family 4 of user 7 from %s", next, device.IP)
+				}
+				if next.TokenHashed != utils.HashToken(newRaw) {
+					t.Errorf("new token hash does not match the returned token")
+				}
+				if len(refreshRepo.rotated) != 1 {
+					t.Errorf("rotated %d times, want 1", len(refreshRepo.rotated))
+				}
+			} else if len(refreshRepo.rotated) != 0 {
+				t.Errorf("rotated on error %v", err)
+			}
+
+			if tt.wantRevoke {
+				if len(refreshRepo.deletedFamily) != 1 || refreshRepo.deletedFamily[0] != 4 {
+					t.Errorf("revoked families = %v, want [4]", refreshRepo.deletedFamily)
+				}
+				if len(securityRepo.events) != 1 {
+					t.Fatalf("security events = %d, want 1", len(securityRepo.events))
+				}
+				e := securityRepo.events[0]
+				if e.Kind != models.SecurityEventRefreshReuse || e.UserID != 7 || e.SessionID != 4 || e.IP != device.IP {
+					t.Errorf("security event = %+v", e)
+				}
+			} else if len(refreshRepo.deletedFamily) != 0 || len(securityRepo.events) != 0 {
+				t.Errorf("revoked %v and recorded %d events, want neither", refreshRepo.deletedFamily, len(securityRepo.events))
+			}
+		})
+	}
+}
+
+func TestSessionServiceRotateTwice(t *testing.T) {
+	useTxOnlyDB(t)
+
+	refreshRepo := &fakeRefreshRepo{tokens: map[string]*models.RefreshToken{}}
+	securityRepo := &fakeSecurityRepo{}
+	s := NewSessionService(refreshRepo, securityRepo, NewJWTService())
+
+	const raw = "first-refresh-token"
+	refreshRepo.tokens[utils.HashToken(raw)] = &models.RefreshToken{
+		ID:          1,
+		FamilyID:    1,
+		UserID:      7,
+		TokenHashed: utils.HashToken(raw),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	if _, _, err := s.Rotate(raw, Device{}); err != nil {
+		t.Fatalf("first Rotate() error = %v", err)
+	}
+	if _, _, err := s.Rotate(raw, Device{}); !errors.Is(err, ErrRefreshRaced) {
+		t.Fatalf("Rotate() of the old token right away = %v, want %v", err, ErrRefreshRaced)
+	}
+
+	// the old token comes back after the grace period
+	old := refreshRepo.tokens[utils.HashToken(raw)]
+	past := time.Now().UTC().Add(-2 * RotationGrace)
+	old.RotatedAt = &past
+
+	if _, _, err := s.Rotate(raw, Device{}); !errors.Is(err, ErrRefreshReused) {
+		t.Fatalf("Rotate() of the old token later = %v, want %v", err, ErrRefreshReused)
+	}
+	if len(refreshRepo.deletedFamily) != 1 {
+		t.Errorf("revoked families = %v, want [1]", refreshRepo.deletedFamily)
+	}
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// TokenVersionTTL is how long a user's token version is trusted before it is
+// read again, i.e. how long a revoked token can keep working at most.
+const TokenVersionTTL = 5 * time.Second
+
+// sweepThreshold is the cache size above which expired entries are dropped.
+const sweepThreshold = 10000
+
+var ErrTokenRevoked = errors.New("token revoked")
+
+type tokenState struct {
+	version  uint
+	active   bool
+	loadedAt time.Time
+}
+
+// TokenVersionCache checks access tokens against User.TokenVersion without a
+// database read on every request.
+type TokenVersionCache struct {
+	userRepo interfaces.UserRepository
+
+	mu      sync.Mutex
+	entries map[uint]tokenState
+}
+
+func NewTokenVersionCache(userRepo interfaces.UserRepository) *TokenVersionCache {
+	return &TokenVersionCache{
+		userRepo: userRepo,
+		entries:  make(map[uint]tokenState),
+	}
+}
+
+// Check returns ErrTokenRevoked when the user was blocked or deleted, or the
+// token predates the user's current version.
+func (c *TokenVersionCache) Check(userID, version uint) error {
+	state, err := c.state(userID)
+	if err != nil {
+		return err
+	}
+	if !state.active || state.version != version {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+func (c *TokenVersionCache) state(userID uint) (tokenState, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	state, ok := c.entries[userID]
+	c.mu.Unlock()
+	if ok && now.Sub(state.loadedAt) < TokenVersionTTL {
+		return state, nil
+	}
+
+	user, err := c.userRepo.FindTokenState(userID)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// deleted users are cached as inactive too
+		state = tokenState{loadedAt: now}
+	case err != nil:
+		return tokenState{}, err
+	default:
+		state = tokenState{
+			version:  user.TokenVersion,
+			active:   user.Status != models.StatusBlocked,
+			loadedAt: now,
+		}
+	}
+
+	c.mu.Lock()
+	if len(c.entries) >= sweepThreshold {
+		for id, s := range c.entries {
+			if now.Sub(s.loadedAt) >= TokenVersionTTL {
+				delete(c.entries, id)
+			}
+		}
+	}
+	c.entries[userID] = state
+	c.mu.Unlock()
+
+	return state, nil
+}
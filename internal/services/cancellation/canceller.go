@@ -0,0 +1,89 @@
+package cancellation
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/waitlist"
+
+	"gorm.io/gorm"
+)
+
+// Canceller is the self-cancellation used by both captains and workers:
+// cutoff check, slot release, waitlist promotion and the cancellation record.
+type Canceller struct {
+	bookingRepo      interfaces.BookingRepository
+	eventRepo        interfaces.EventRepository
+	cancellationRepo interfaces.BookingCancellationRepository
+	settingRepo      interfaces.SettingRepository
+	waitlistService  *waitlist.WaitlistService
+}
+
+func NewCanceller(
+	bookingRepo interfaces.BookingRepository,
+	eventRepo interfaces.EventRepository,
+	cancellationRepo interfaces.BookingCancellationRepository,
+	settingRepo interfaces.SettingRepository,
+	waitlistService *waitlist.WaitlistService,
+) *Canceller {
+	return &Canceller{
+		bookingRepo:      bookingRepo,
+		eventRepo:        eventRepo,
+		cancellationRepo: cancellationRepo,
+		settingRepo:      settingRepo,
+		waitlistService:  waitlistService,
+	}
+}
+
+// CancelOwn cancels a booking on behalf of the user who holds it.
+func (c *Canceller) CancelOwn(userID, bookingID uint) error {
+	cutoff := time.Duration(c.settingRepo.GetInt(models.SettingBookingCancelCutoffHours, 2)) * time.Hour
+	lateWindow := time.Duration(c.settingRepo.GetInt(models.SettingBookingLateCancelHours, 24)) * time.Hour
+
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		booking, err := c.bookingRepo.FindByIDForUpdate(tx, bookingID)
+		if err != nil || booking.UserID != userID {
+			return errors.New("booking not found or unauthorized")
+		}
+
+		event, err := c.eventRepo.FindByIDForUpdate(tx, booking.EventID)
+		if err != nil {
+			return errors.New("event not found")
+		}
+
+		if event.Status != models.EventStatusUpcoming {
+			return errors.New("only bookings for upcoming events can be cancelled")
+		}
+
+		now := time.Now()
+		untilReporting := event.ReportingAt().Sub(now)
+		if untilReporting < cutoff {
+			return errors.New("cancellation window has closed for this event")
+		}
+
+		event.ReleaseSlot(booking.Role)
+		if err := c.waitlistService.PromoteTx(tx, event); err != nil {
+			return err
+		}
+		if err := c.eventRepo.SaveTx(tx, event); err != nil {
+			return err
+		}
+
+		if err := c.cancellationRepo.CreateTx(tx, &models.BookingCancellation{
+			BookingID:              booking.ID,
+			EventID:                booking.EventID,
+			UserID:                 booking.UserID,
+			Role:                   booking.Role,
+			MinutesBeforeReporting: int64(untilReporting.Minutes()),
+			IsLate:                 untilReporting < lateWindow,
+			CancelledAt:            now,
+		}); err != nil {
+			return err
+		}
+
+		return c.bookingRepo.DeleteTx(tx, booking.ID)
+	})
+}
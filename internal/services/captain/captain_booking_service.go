@@ -7,6 +7,11 @@ import (
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/waitlist"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/cancellation"
+	"event-management-backend/internal/services/travel"
+	"event-management-backend/internal/services/wages"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -18,20 +23,38 @@ type CaptainBookingResponse struct {
 }
 
 type CaptainBookingService struct {
-	bookingRepo interfaces.BookingRepository
-	eventRepo   interfaces.EventRepository
-	userRepo    interfaces.UserRepository
+	bookingRepo      interfaces.BookingRepository
+	eventRepo        interfaces.EventRepository
+	userRepo         interfaces.UserRepository
+	settingRepo      interfaces.SettingRepository
+	canceller        *cancellation.Canceller
+	waitlistService  *waitlist.WaitlistService
+	wageResolver     *wages.WageResolver
+	taCalculator     *travel.TACalculator
+	adjustments      *adjustments.Applier
 }
 
 func NewCaptainBookingService(
 	bookingRepo interfaces.BookingRepository,
 	eventRepo interfaces.EventRepository,
 	userRepo interfaces.UserRepository,
+	cancellationRepo interfaces.BookingCancellationRepository,
+	settingRepo interfaces.SettingRepository,
+	waitlistService *waitlist.WaitlistService,
+	wageResolver *wages.WageResolver,
+	taCalculator *travel.TACalculator,
+	adjustments *adjustments.Applier,
 ) *CaptainBookingService {
 	return &CaptainBookingService{
-		bookingRepo: bookingRepo,
-		eventRepo:   eventRepo,
-		userRepo:    userRepo,
+		bookingRepo:      bookingRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		settingRepo:      settingRepo,
+		canceller:        cancellation.NewCanceller(bookingRepo, eventRepo, cancellationRepo, settingRepo, waitlistService),
+		waitlistService:  waitlistService,
+		wageResolver:     wageResolver,
+		taCalculator:     taCalculator,
+		adjustments:      adjustments,
 	}
 }
 
@@ -57,12 +80,11 @@ func (s *CaptainBookingService) BookEvent(userID, eventID uint) error {
 			return errors.New("already booked")
 		}
 
-		if event.RemainingCaptains == 0 {
+		if !event.TakeSlot(models.RoleCaptain) {
 			return errors.New("no captain slots available")
 		}
 
-		event.RemainingCaptains--
-		if err := tx.Save(event).Error; err != nil {
+		if err := s.eventRepo.SaveTx(tx, event); err != nil {
 			return err
 		}
 
@@ -71,18 +93,24 @@ func (s *CaptainBookingService) BookEvent(userID, eventID uint) error {
 			UserID:     userID,
 			Role:       models.RoleCaptain,
 			Status:     models.BookingStatusBooked,
-			BaseAmount: user.CurrentWage,
+			BaseAmount: s.wageResolver.BaseWage(tx, user, models.RoleCaptain, event.Date),
 		}
 
 		return tx.Create(booking).Error
 	})
 }
 
+// ======================= CANCEL BOOKING =======================
+func (s *CaptainBookingService) CancelBooking(userID, bookingID uint) error {
+	return s.canceller.CancelOwn(userID, bookingID)
+}
+
 // ======================= INTERNAL HELPER =======================
 func mapBookingResponses(bookings []models.Booking) []CaptainBookingResponse {
 	res := make([]CaptainBookingResponse, 0, len(bookings))
 
 	for _, b := range bookings {
+		paymentStatus, paidAt := b.PaymentStatus()
 		res = append(res, CaptainBookingResponse{
 			Event: b.Event,
 			MyBooking: BookingDTO{
@@ -97,6 +125,11 @@ func mapBookingResponses(bookings []models.Booking) []CaptainBookingResponse {
 				FineAmount:  b.FineAmount,
 				TotalAmount: b.TotalAmount,
 				CreatedAt:   b.CreatedAt.Format(time.RFC3339),
+
+				AdvanceDeduction: b.AdvanceDeduction,
+
+				PaymentStatus: paymentStatus,
+				PaidAt:        paidAt,
 			},
 		})
 	}
@@ -112,6 +145,7 @@ func (s *CaptainBookingService) ListTodayBookings(userID uint) ([]CaptainBooking
 
 	err := config.DB.
 		Preload("Event").
+		Preload("Payout").
 		Joins("JOIN events ON events.id = bookings.event_id").
 		Where(`
 			bookings.user_id = ?
@@ -122,7 +156,7 @@ func (s *CaptainBookingService) ListTodayBookings(userID uint) ([]CaptainBooking
 		Order("events.date ASC").
 		Find(&bookings).Error
 
-	return mapBookingResponses(bookings), err
+	return s.withBookingPending(mapBookingResponses(bookings), err)
 }
 
 // ======================= UPCOMING BOOKINGS =======================
@@ -147,7 +181,7 @@ func (s *CaptainBookingService) ListUpcomingBookings(userID uint) ([]CaptainBook
         return nil, err
     }
 
-    return mapBookingResponses(bookings), nil
+    return s.withBookingPending(mapBookingResponses(bookings), nil)
 }
 
 // ======================= COMPLETED BOOKINGS =======================
@@ -156,6 +190,7 @@ func (s *CaptainBookingService) ListCompletedBookings(userID uint) ([]CaptainBoo
 
 	err := config.DB.
 		Preload("Event").
+		Preload("Payout").
 		Joins("JOIN events ON events.id = bookings.event_id").
 		Where(`
 			bookings.user_id = ?
@@ -165,7 +200,7 @@ func (s *CaptainBookingService) ListCompletedBookings(userID uint) ([]CaptainBoo
 		Order("events.date DESC").
 		Find(&bookings).Error
 
-	return mapBookingResponses(bookings), err
+	return s.withBookingPending(mapBookingResponses(bookings), err)
 }
 
 // ======================= LIST EVENT BOOKINGS =======================
@@ -190,16 +225,22 @@ func (s *CaptainBookingService) ListEventBookings(captainID, eventID uint) ([]At
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where("bookings.event_id = ? AND bookings.deleted_at IS NULL", eventID).
 		Order("bookings.role ASC").
 		Scan(&rows).Error
 
-	return rows, err
+	return s.withRowPending(rows, err)
 }
 
 // ======================= UPDATE ATTENDANCE =======================
@@ -207,7 +248,8 @@ func (s *CaptainBookingService) UpdateAttendance(
 	captainID uint,
 	bookingID uint,
 	status string,
-	ta, bonus, fine int64,
+	ta *int64,
+	ruleIDs []uint,
 ) (*models.Booking, error) {
 
 	var updatedBooking *models.Booking
@@ -250,14 +292,23 @@ func (s *CaptainBookingService) UpdateAttendance(
 		}
 
 		booking.Status = status
+		if status == models.BookingStatusPresent && booking.CheckInMethod == "" {
+			booking.CheckInMethod = models.CheckInMethodManual
+		}
 
 		if status == models.BookingStatusAbsent {
 			booking.BaseAmount = 0
 			booking.ExtraAmount = 0
 			booking.TAAmount = 0
-			booking.BonusAmount = 0
-			booking.FineAmount = 0
-			booking.TotalAmount = 0
+			booking.TAPolicyID = nil
+			booking.TADistanceKm = nil
+			booking.TARule = ""
+			booking.TAOverridden = false
+			booking.RecalcTotal()
+
+			if err := s.adjustments.ClearTx(tx, &booking); err != nil {
+				return err
+			}
 
 			if err := tx.Save(&booking).Error; err != nil {
 				return err
@@ -266,7 +317,7 @@ func (s *CaptainBookingService) UpdateAttendance(
 			return nil
 		}
 
-		if ta < 0 || bonus < 0 || fine < 0 {
+		if ta != nil && *ta < 0 {
 			return errors.New("amounts cannot be negative")
 		}
 
@@ -277,10 +328,18 @@ func (s *CaptainBookingService) UpdateAttendance(
 			return errors.New("worker user not found")
 		}
 
-		booking.BaseAmount = user.CurrentWage
-		booking.TAAmount = ta
-		booking.BonusAmount = bonus
-		booking.FineAmount = fine
+		booking.BaseAmount = s.wageResolver.BaseWage(tx, &user, booking.Role, event.Date)
+
+		// amounts above the role thresholds wait for admin approval
+		ta, err := s.adjustments.HoldTATx(tx, &booking, ta, &captainID)
+		if err != nil {
+			return err
+		}
+		s.taCalculator.ApplyTx(tx, &booking, &user, &event, ta)
+
+		if err := s.adjustments.ReplaceRulesTx(tx, &booking, ruleIDs, &captainID, true); err != nil {
+			return err
+		}
 
 		if event.LongWork {
 			booking.ExtraAmount = event.ExtraWageAmount
@@ -288,16 +347,7 @@ func (s *CaptainBookingService) UpdateAttendance(
 			booking.ExtraAmount = 0
 		}
 
-		booking.TotalAmount =
-			booking.BaseAmount +
-				booking.ExtraAmount +
-				booking.TAAmount +
-				booking.BonusAmount -
-				booking.FineAmount
-
-		if booking.TotalAmount < 0 {
-			booking.TotalAmount = 0
-		}
+		booking.RecalcTotal()
 
 		if err := tx.Save(&booking).Error; err != nil {
 			return err
@@ -342,9 +392,15 @@ func (s *CaptainBookingService) ListEventBookingsByStatus(
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where(`
@@ -355,7 +411,7 @@ func (s *CaptainBookingService) ListEventBookingsByStatus(
 		Order("users.name ASC").
 		Scan(&rows).Error
 
-	return rows, err
+	return s.withRowPending(rows, err)
 }
 
 // ======================= SEARCH BY NAME =======================
@@ -381,9 +437,15 @@ func (s *CaptainBookingService) SearchEventBookingsByName(
 			bookings.base_amount,
 			bookings.extra_amount,
 			bookings.ta_amount,
+			bookings.ta_rule,
+			bookings.ta_overridden,
 			bookings.bonus_amount,
 			bookings.fine_amount,
-			bookings.total_amount
+			bookings.total_amount,
+			bookings.checked_in_at,
+			bookings.checked_out_at,
+			bookings.check_in_method,
+			` + models.WorkedHoursColumn + `
 		`).
 		Joins("JOIN users ON users.id = bookings.user_id").
 		Where(`
@@ -394,7 +456,7 @@ func (s *CaptainBookingService) SearchEventBookingsByName(
 		Order("users.name ASC").
 		Scan(&rows).Error
 
-	return rows, err
+	return s.withRowPending(rows, err)
 }
 
 // ======================= EVENT WAGE SUMMERY =======================
@@ -413,6 +475,7 @@ func (s *CaptainBookingService) GetEventWageSummary(
 			COALESCE(SUM(ta_amount),0)    as ta_total,
 			COALESCE(SUM(bonus_amount),0) as bonus_total,
 			COALESCE(SUM(fine_amount),0)  as fine_total,
+			COALESCE(SUM(advance_deduction),0) as advance_total,
 			COALESCE(SUM(total_amount),0) as grand_total
 		`).
 		Where(`
@@ -426,8 +489,69 @@ func (s *CaptainBookingService) GetEventWageSummary(
 		return nil, err
 	}
 
+	summary.Rules, err = s.adjustments.BreakdownForEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &summary, nil
 }
+
+// ======================= PENDING ADJUSTMENTS =======================
+// Amounts waiting for admin approval are shown next to the approved ones.
+func (s *CaptainBookingService) withBookingPending(res []CaptainBookingResponse, err error) ([]CaptainBookingResponse, error) {
+	if err != nil || len(res) == 0 {
+		return res, err
+	}
+
+	ids := make([]uint, 0, len(res))
+	for _, r := range res {
+		ids = append(ids, r.MyBooking.ID)
+	}
+
+	pending, err := s.adjustments.PendingFor(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		res[i].MyBooking.Pending = pending[res[i].MyBooking.ID]
+	}
+	return res, nil
+}
+
+func (s *CaptainBookingService) withRowPending(rows []AttendanceRowResponse, err error) ([]AttendanceRowResponse, error) {
+	if err != nil || len(rows) == 0 {
+		return rows, err
+	}
+
+	ids := make([]uint, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.BookingID)
+	}
+
+	pending, err := s.adjustments.PendingFor(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].Pending = pending[rows[i].BookingID]
+	}
+	return rows, nil
+}
+
+// ======================= ADJUSTMENT RULES =======================
+func (s *CaptainBookingService) ListAdjustmentRules() ([]models.AdjustmentRule, error) {
+	return s.adjustments.ActiveRules()
+}
+
+// ======================= TA PROPOSALS =======================
+func (s *CaptainBookingService) ListTAProposals(captainID, eventID uint) ([]travel.BookingProposal, error) {
+	if err := s.verifyCaptain(captainID, eventID); err != nil {
+		return nil, err
+	}
+	return s.taCalculator.ProposeForEvent(eventID)
+}
+
 // ======================= INTERNAL =======================
 func (s *CaptainBookingService) verifyCaptain(captainID, eventID uint) error {
 	var count int64
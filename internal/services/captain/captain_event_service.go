@@ -2,27 +2,30 @@ package captain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
 
 	"gorm.io/gorm"
 )
 
 type CaptainEventService struct {
-	repo interfaces.EventRepository
+	repo         interfaces.EventRepository
+	wageResolver *wages.WageResolver
 }
 
-func NewCaptainEventService(repo interfaces.EventRepository) *CaptainEventService {
-	return &CaptainEventService{repo: repo}
+func NewCaptainEventService(repo interfaces.EventRepository, wageResolver *wages.WageResolver) *CaptainEventService {
+	return &CaptainEventService{repo: repo, wageResolver: wageResolver}
 }
 
 // ---------------- VIEW ----------------
 func (s *CaptainEventService) ListAvailableEvents(userID uint) ([]models.Event, error) {
 	today := time.Now().Truncate(24 * time.Hour)
-	return s.repo.ListAvailableForCaptain(userID, today)
+	return s.repo.ListAvailableForRole(userID, models.RoleCaptain, today)
 }
 
 func (s *CaptainEventService) GetEvent(id uint) (*models.Event, error) {
@@ -35,41 +38,42 @@ func (s *CaptainEventService) GetEvent(id uint) (*models.Event, error) {
 
 // ---------------- STATUS CONTROL ----------------
 func (s *CaptainEventService) StartEvent(captainID, eventID uint) error {
-	event, err := s.repo.FindByID(eventID)
-	if err != nil {
-		return err
-	}
-
 	if !s.isCaptainOfEvent(captainID, eventID) {
 		return errors.New("you are not authorized to start this event")
 	}
 
-	if event.Status != models.EventStatusUpcoming {
-		return errors.New("only upcoming events can be started")
-	}
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		event, err := s.repo.FindByIDForUpdate(tx, eventID)
+		if err != nil {
+			return err
+		}
+
+		if event.Status != models.EventStatusUpcoming {
+			return errors.New("only upcoming events can be started")
+		}
 
-	event.Status = models.EventStatusOngoing
-	return s.repo.Update(event)
+		event.Status = models.EventStatusOngoing
+		return s.repo.SaveTx(tx, event)
+	})
 }
 
 func (s *CaptainEventService) CompleteEvent(captainID, eventID uint) error {
-	event, err := s.repo.FindByID(eventID)
-	if err != nil {
-		return err
-	}
-
 	if !s.isCaptainOfEvent(captainID, eventID) {
 		return errors.New("you are not authorized to complete this event")
 	}
 
-	if event.Status != models.EventStatusOngoing {
-		return errors.New("only ongoing events can be completed")
-	}
-
 	return config.DB.Transaction(func(tx *gorm.DB) error {
+		event, err := s.repo.FindByIDForUpdate(tx, eventID)
+		if err != nil {
+			return err
+		}
+
+		if event.Status != models.EventStatusOngoing {
+			return errors.New("only ongoing events can be completed")
+		}
 
 		event.Status = models.EventStatusCompleted
-		if err := tx.Save(event).Error; err != nil {
+		if err := s.repo.SaveTx(tx, event); err != nil {
 			return err
 		}
 
@@ -100,7 +104,17 @@ func (s *CaptainEventService) CompleteEvent(captainID, eventID uint) error {
 			return err
 		}
 
-		return nil
+		// ---------------- SENIORITY WAGE TIERS ----------------
+		var userIDs []uint
+		if err := tx.
+			Model(&models.Booking{}).
+			Where("event_id = ? AND status = ? AND deleted_at IS NULL", eventID, models.BookingStatusCompleted).
+			Pluck("user_id", &userIDs).Error; err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("completed event #%d", eventID)
+		return s.wageResolver.SyncUsersTx(tx, userIDs, models.WageChangeSourceSeniority, reason)
 	})
 }
 
@@ -1,5 +1,11 @@
 package captain
 
+import (
+	"time"
+
+	"event-management-backend/internal/services/adjustments"
+)
+
 type BookingDTO struct {
 	ID          uint   `json:"id"`
 	EventID     uint   `json:"event_id"`
@@ -12,6 +18,14 @@ type BookingDTO struct {
 	FineAmount  int64  `json:"fine_amount"`
 	TotalAmount int64  `json:"total_amount"`
 	CreatedAt   string `json:"created_at"`
+
+	AdvanceDeduction int64 `json:"advance_deduction"`
+
+	PaymentStatus string     `json:"payment_status"`
+	PaidAt        *time.Time `json:"paid_at"`
+
+	// amounts waiting for admin approval, nil when none
+	Pending *adjustments.Pending `json:"pending"`
 }
 
 type AttendanceRowResponse struct {
@@ -21,12 +35,22 @@ type AttendanceRowResponse struct {
 	Role       string `json:"role"`
 	Status     string `json:"status"`
 
-	BaseAmount  int64 `json:"base_amount"`
-	ExtraAmount int64 `json:"extra_amount"`
-	TAAmount    int64 `json:"ta_amount"`
-	BonusAmount int64 `json:"bonus_amount"`
-	FineAmount  int64 `json:"fine_amount"`
-	TotalAmount int64 `json:"total_amount"`
+	BaseAmount   int64  `json:"base_amount"`
+	ExtraAmount  int64  `json:"extra_amount"`
+	TAAmount     int64  `json:"ta_amount"`
+	TARule       string `json:"ta_rule"`
+	TAOverridden bool   `json:"ta_overridden"`
+	BonusAmount  int64  `json:"bonus_amount"`
+	FineAmount   int64  `json:"fine_amount"`
+	TotalAmount  int64  `json:"total_amount"`
+
+	CheckedInAt   *time.Time `json:"checked_in_at"`
+	CheckedOutAt  *time.Time `json:"checked_out_at"`
+	CheckInMethod string     `json:"check_in_method"`
+	WorkedHours   float64    `json:"worked_hours"`
+
+	// amounts waiting for admin approval, nil when none
+	Pending *adjustments.Pending `gorm:"-" json:"pending"`
 }
 
 type EventWageSummary struct {
@@ -37,6 +61,10 @@ type EventWageSummary struct {
 	TATotal      int64 `json:"ta_total"`
 	BonusTotal   int64 `json:"bonus_total"`
 	FineTotal    int64 `json:"fine_total"`
+	// advances recovered at payroll close, already taken off the grand total
+	AdvanceTotal int64 `json:"advance_total"`
 
 	GrandTotal   int64 `json:"grand_total"`
+
+	Rules []adjustments.RuleTotal `gorm:"-" json:"rules"`
 }
\ No newline at end of file
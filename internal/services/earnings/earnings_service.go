@@ -0,0 +1,146 @@
+package earnings
+
+import (
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// Components are the summed wage parts of a set of bookings, the same
+// aggregates as the event wage summary plus recovered advances.
+type Components struct {
+	Bookings     int64 `json:"bookings"`
+	BaseTotal    int64 `json:"base_total"`
+	ExtraTotal   int64 `json:"extra_total"`
+	TATotal      int64 `json:"ta_total"`
+	BonusTotal   int64 `json:"bonus_total"`
+	FineTotal    int64 `json:"fine_total"`
+	AdvanceTotal int64 `json:"advance_total"`
+	GrandTotal   int64 `json:"grand_total"`
+}
+
+type MonthTotal struct {
+	Month string `json:"month"` // YYYY-MM
+	Components
+}
+
+type EventTotal struct {
+	EventID   uint      `json:"event_id"`
+	EventName string    `json:"event_name"`
+	Date      time.Time `json:"date"`
+	Role      string    `json:"role"`
+	Components
+}
+
+// Statement is a user's earnings over the requested range, with running
+// totals for the current month and year that ignore the range.
+type Statement struct {
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+
+	Totals    Components `json:"totals"`
+	ThisMonth Components `json:"this_month"`
+	ThisYear  Components `json:"this_year"`
+
+	ByMonth []MonthTotal `json:"by_month"`
+	ByEvent []EventTotal `json:"by_event"`
+}
+
+const componentSelect = `
+	COUNT(bookings.id)                           AS bookings,
+	COALESCE(SUM(bookings.base_amount),0)        AS base_total,
+	COALESCE(SUM(bookings.extra_amount),0)       AS extra_total,
+	COALESCE(SUM(bookings.ta_amount),0)          AS ta_total,
+	COALESCE(SUM(bookings.bonus_amount),0)       AS bonus_total,
+	COALESCE(SUM(bookings.fine_amount),0)        AS fine_total,
+	COALESCE(SUM(bookings.advance_deduction),0)  AS advance_total,
+	COALESCE(SUM(bookings.total_amount),0)       AS grand_total
+`
+
+// EarningsService reports what a user has earned from completed bookings.
+// It is shared by the worker and captain endpoints.
+type EarningsService struct{}
+
+func NewEarningsService() *EarningsService {
+	return &EarningsService{}
+}
+
+// ForUser builds the statement. from and to are inclusive event dates and
+// may be nil.
+func (s *EarningsService) ForUser(userID uint, from, to *time.Time) (*Statement, error) {
+	st := &Statement{
+		From:    from,
+		To:      to,
+		ByMonth: make([]MonthTotal, 0),
+		ByEvent: make([]EventTotal, 0),
+	}
+
+	if err := s.earned(userID, from, to).
+		Select(componentSelect).
+		Scan(&st.Totals).Error; err != nil {
+		return nil, err
+	}
+
+	if err := s.earned(userID, from, to).
+		Select("TO_CHAR(events.date, 'YYYY-MM') AS month, " + componentSelect).
+		Group("TO_CHAR(events.date, 'YYYY-MM')").
+		Order("month DESC").
+		Scan(&st.ByMonth).Error; err != nil {
+		return nil, err
+	}
+
+	if err := s.earned(userID, from, to).
+		Select(`
+			events.id         AS event_id,
+			events.event_name AS event_name,
+			events.date       AS date,
+			bookings.role     AS role,
+		` + componentSelect).
+		Group("events.id, events.event_name, events.date, bookings.role").
+		Order("events.date DESC").
+		Scan(&st.ByEvent).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	yearStart := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+
+	if err := s.earned(userID, &monthStart, &now).
+		Select(componentSelect).
+		Scan(&st.ThisMonth).Error; err != nil {
+		return nil, err
+	}
+	if err := s.earned(userID, &yearStart, &now).
+		Select(componentSelect).
+		Scan(&st.ThisYear).Error; err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// earned selects the user's completed bookings of completed events.
+func (s *EarningsService) earned(userID uint, from, to *time.Time) *gorm.DB {
+	q := config.DB.
+		Table("bookings").
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Where(`
+			bookings.user_id = ?
+			AND bookings.status = ?
+			AND bookings.deleted_at IS NULL
+			AND events.status = ?
+			AND events.deleted_at IS NULL
+		`, userID, models.BookingStatusCompleted, models.EventStatusCompleted)
+
+	if from != nil {
+		q = q.Where("DATE(events.date) >= DATE(?)", *from)
+	}
+	if to != nil {
+		q = q.Where("DATE(events.date) <= DATE(?)", *to)
+	}
+	return q
+}
@@ -0,0 +1,184 @@
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Payment is one transfer in a bank bulk-payment file. Amounts are in
+// rupees.
+type Payment struct {
+	Reference       string
+	BeneficiaryName string
+	AccountNumber   string
+	IFSC            string
+	BankName        string
+	Amount          int64
+	Narration       string
+}
+
+// BankFormat is a bulk-payment file layout. Formats are stateless; BankFile
+// keeps the running count and total for the footer.
+type BankFormat interface {
+	ContentType() string
+	Extension() string
+	Header(w io.Writer, valueDate time.Time) error
+	Write(w io.Writer, p Payment, valueDate time.Time) error
+	Footer(w io.Writer, count int, total int64) error
+}
+
+var bankFormats = map[string]BankFormat{
+	"neft_csv":   neftCSV{},
+	"neft_fixed": neftFixed{},
+}
+
+// RegisterBankFormat adds or replaces a bank file layout.
+func RegisterBankFormat(name string, f BankFormat) {
+	bankFormats[name] = f
+}
+
+func BankFormatByName(name string) (BankFormat, error) {
+	f, ok := bankFormats[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown bank format, use one of: %s", strings.Join(BankFormatNames(), ", "))
+	}
+	return f, nil
+}
+
+func BankFormatNames() []string {
+	names := make([]string, 0, len(bankFormats))
+	for name := range bankFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BankFile streams payments through a format.
+type BankFile struct {
+	w         io.Writer
+	format    BankFormat
+	valueDate time.Time
+	count     int
+	total     int64
+}
+
+func NewBankFile(w io.Writer, format BankFormat, valueDate time.Time) (*BankFile, error) {
+	if err := format.Header(w, valueDate); err != nil {
+		return nil, err
+	}
+	return &BankFile{w: w, format: format, valueDate: valueDate}, nil
+}
+
+func (f *BankFile) Add(p Payment) error {
+	if p.Amount <= 0 {
+		return errors.New("payment amount must be positive")
+	}
+	if err := f.format.Write(f.w, p, f.valueDate); err != nil {
+		return err
+	}
+	f.count++
+	f.total += p.Amount
+	return nil
+}
+
+func (f *BankFile) Close() error {
+	return f.format.Footer(f.w, f.count, f.total)
+}
+
+// ---------------- NEFT CSV ----------------
+// One header row, then one row per transfer. Most net-banking bulk upload
+// screens accept this after mapping the columns once.
+
+type neftCSV struct{}
+
+func (neftCSV) ContentType() string { return "text/csv" }
+func (neftCSV) Extension() string   { return "csv" }
+
+func (neftCSV) Header(w io.Writer, _ time.Time) error {
+	return writeCSV(w, []string{
+		"Transaction Type", "Beneficiary Name", "Beneficiary Account No",
+		"IFSC", "Bank Name", "Amount", "Value Date", "Narration", "Reference",
+	})
+}
+
+func (neftCSV) Write(w io.Writer, p Payment, valueDate time.Time) error {
+	return writeCSV(w, []string{
+		"NEFT",
+		p.BeneficiaryName,
+		p.AccountNumber,
+		strings.ToUpper(p.IFSC),
+		p.BankName,
+		fmt.Sprintf("%d.00", p.Amount),
+		valueDate.Format("02/01/2006"),
+		p.Narration,
+		p.Reference,
+	})
+}
+
+func (neftCSV) Footer(io.Writer, int, int64) error { return nil }
+
+func writeCSV(w io.Writer, record []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ---------------- NEFT FIXED WIDTH ----------------
+// H record: "H", value date YYYYMMDD.
+// D record: "D", reference 16, name 35, account 20, IFSC 11, amount in
+// paise zero padded to 15, narration 30.
+// T record: "T", record count 6, total in paise 18.
+// Text is upper-cased ASCII, left aligned and space padded.
+
+type neftFixed struct{}
+
+func (neftFixed) ContentType() string { return "text/plain" }
+func (neftFixed) Extension() string   { return "txt" }
+
+func (neftFixed) Header(w io.Writer, valueDate time.Time) error {
+	_, err := fmt.Fprintf(w, "H%s\r\n", valueDate.Format("20060102"))
+	return err
+}
+
+func (neftFixed) Write(w io.Writer, p Payment, _ time.Time) error {
+	_, err := fmt.Fprintf(w, "D%s%s%s%s%015d%s\r\n",
+		fixed(p.Reference, 16),
+		fixed(p.BeneficiaryName, 35),
+		fixed(p.AccountNumber, 20),
+		fixed(p.IFSC, 11),
+		p.Amount*100,
+		fixed(p.Narration, 30),
+	)
+	return err
+}
+
+func (neftFixed) Footer(w io.Writer, count int, total int64) error {
+	_, err := fmt.Fprintf(w, "T%06d%018d\r\n", count, total*100)
+	return err
+}
+
+func fixed(s string, width int) string {
+	out := make([]byte, 0, width)
+	for _, r := range strings.ToUpper(s) {
+		if r < 0x20 || r > 0x7e {
+			continue
+		}
+		out = append(out, byte(r))
+		if len(out) == width {
+			break
+		}
+	}
+	for len(out) < width {
+		out = append(out, ' ')
+	}
+	return string(out)
+}
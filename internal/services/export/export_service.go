@@ -0,0 +1,300 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+var wageColumns = []any{
+	"Booking ID", "Worker", "Phone", "Role", "Status",
+	"Base", "Extra", "TA", "Bonus", "Fine", "Advance", "Total",
+}
+
+type wageRow struct {
+	EventDate        time.Time
+	EventName        string
+	BookingID        uint
+	UserName         string
+	Phone            string
+	Role             string
+	Status           string
+	BaseAmount       int64
+	ExtraAmount      int64
+	TAAmount         int64
+	BonusAmount      int64
+	FineAmount       int64
+	AdvanceDeduction int64
+	TotalAmount      int64
+}
+
+func (r *wageRow) amounts() []any {
+	return []any{
+		r.BaseAmount, r.ExtraAmount, r.TAAmount, r.BonusAmount,
+		r.FineAmount, r.AdvanceDeduction, r.TotalAmount,
+	}
+}
+
+func (r *wageRow) add(o *wageRow) {
+	r.BaseAmount += o.BaseAmount
+	r.ExtraAmount += o.ExtraAmount
+	r.TAAmount += o.TAAmount
+	r.BonusAmount += o.BonusAmount
+	r.FineAmount += o.FineAmount
+	r.AdvanceDeduction += o.AdvanceDeduction
+	r.TotalAmount += o.TotalAmount
+}
+
+const wageSelect = `
+	events.date AS event_date,
+	events.event_name,
+	bookings.id AS booking_id,
+	users.name AS user_name,
+	users.phone,
+	bookings.role,
+	bookings.status,
+	bookings.base_amount,
+	bookings.extra_amount,
+	bookings.ta_amount,
+	bookings.bonus_amount,
+	bookings.fine_amount,
+	bookings.advance_deduction,
+	bookings.total_amount
+`
+
+// ExportService writes wage sheets and bank bulk-payment files. Rows are
+// read with a cursor and written as they arrive.
+type ExportService struct {
+	eventRepo   interfaces.EventRepository
+	payrollRepo interfaces.PayrollRepository
+}
+
+func NewExportService(
+	eventRepo interfaces.EventRepository,
+	payrollRepo interfaces.PayrollRepository,
+) *ExportService {
+	return &ExportService{
+		eventRepo:   eventRepo,
+		payrollRepo: payrollRepo,
+	}
+}
+
+// ---------------- PER EVENT ----------------
+
+func (s *ExportService) FindEvent(eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	return event, nil
+}
+
+// WriteEventWages writes every booking of the event with a total row,
+// matching the event wage summary.
+func (s *ExportService) WriteEventWages(sheet Sheet, eventID uint) error {
+	if err := sheet.WriteRow(wageColumns...); err != nil {
+		return err
+	}
+
+	rows, err := config.DB.
+		Table("bookings").
+		Select(wageSelect).
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Joins("JOIN users ON users.id = bookings.user_id").
+		Where("bookings.event_id = ? AND bookings.deleted_at IS NULL", eventID).
+		Order("bookings.role ASC, users.name ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var total wageRow
+	for rows.Next() {
+		var r wageRow
+		if err := config.DB.ScanRows(rows, &r); err != nil {
+			return err
+		}
+		if r.Status != models.BookingStatusAbsent {
+			total.add(&r)
+		}
+
+		cells := append([]any{r.BookingID, r.UserName, r.Phone, r.Role, r.Status}, r.amounts()...)
+		if err := sheet.WriteRow(cells...); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	cells := append([]any{"", "Total", "", "", ""}, total.amounts()...)
+	if err := sheet.WriteRow(cells...); err != nil {
+		return err
+	}
+	return sheet.Close()
+}
+
+// ---------------- DATE RANGE ----------------
+
+// WriteRangeWages writes the non-absent bookings of completed events dated
+// between from and to, inclusive, with a total row.
+func (s *ExportService) WriteRangeWages(sheet Sheet, from, to time.Time) error {
+	if err := sheet.WriteRow(append([]any{"Date", "Event"}, wageColumns...)...); err != nil {
+		return err
+	}
+
+	rows, err := config.DB.
+		Table("bookings").
+		Select(wageSelect).
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Joins("JOIN users ON users.id = bookings.user_id").
+		Where(`
+			bookings.deleted_at IS NULL
+			AND bookings.status != ?
+			AND events.deleted_at IS NULL
+			AND events.status = ?
+			AND DATE(events.date) BETWEEN DATE(?) AND DATE(?)
+		`, models.BookingStatusAbsent, models.EventStatusCompleted, from, to).
+		Order("events.date ASC, events.id ASC, users.name ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var total wageRow
+	for rows.Next() {
+		var r wageRow
+		if err := config.DB.ScanRows(rows, &r); err != nil {
+			return err
+		}
+		total.add(&r)
+
+		cells := append([]any{
+			r.EventDate, r.EventName,
+			r.BookingID, r.UserName, r.Phone, r.Role, r.Status,
+		}, r.amounts()...)
+		if err := sheet.WriteRow(cells...); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	cells := append([]any{"", "Total", "", "", "", "", ""}, total.amounts()...)
+	if err := sheet.WriteRow(cells...); err != nil {
+		return err
+	}
+	return sheet.Close()
+}
+
+// ---------------- BANK FILE ----------------
+
+// CheckBankFile makes sure the period is closed and every unpaid payout
+// has bank details, so the file is not cut short once streaming starts.
+func (s *ExportService) CheckBankFile(periodID uint) error {
+	period, err := s.payrollRepo.FindPeriodByID(periodID)
+	if err != nil {
+		return errors.New("payroll period not found")
+	}
+	if period.Status != models.PayrollPeriodClosed {
+		return errors.New("payroll period is not closed yet")
+	}
+
+	var missing []string
+	if err := config.DB.
+		Table("payouts").
+		Joins("JOIN users ON users.id = payouts.user_id").
+		Where(`
+			payouts.period_id = ?
+			AND payouts.status = ?
+			AND payouts.total_amount > 0
+			AND (users.bank_account_number = '' OR users.bank_account_number IS NULL
+				OR users.bank_ifsc = '' OR users.bank_ifsc IS NULL)
+		`, periodID, models.PayoutStatusPending).
+		Order("users.name ASC").
+		Pluck("users.name", &missing).Error; err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing bank details for: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// WriteBankFile writes one transfer per unpaid payout of the period.
+func (s *ExportService) WriteBankFile(w io.Writer, format BankFormat, periodID uint, valueDate time.Time) error {
+	rows, err := config.DB.
+		Table("payouts").
+		Select(`
+			payouts.id,
+			payouts.total_amount,
+			users.name,
+			users.bank_account_name,
+			users.bank_account_number,
+			users.bank_ifsc,
+			users.bank_name
+		`).
+		Joins("JOIN users ON users.id = payouts.user_id").
+		Where(`
+			payouts.period_id = ?
+			AND payouts.status = ?
+			AND payouts.total_amount > 0
+		`, periodID, models.PayoutStatusPending).
+		Order("payouts.id ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	file, err := NewBankFile(w, format, valueDate)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var r struct {
+			ID                uint
+			TotalAmount       int64
+			Name              string
+			BankAccountName   string
+			BankAccountNumber string
+			BankIFSC          string
+			BankName          string
+		}
+		if err := config.DB.ScanRows(rows, &r); err != nil {
+			return err
+		}
+
+		beneficiary := r.BankAccountName
+		if beneficiary == "" {
+			beneficiary = r.Name
+		}
+
+		if err := file.Add(Payment{
+			Reference:       fmt.Sprintf("PAYOUT%d", r.ID),
+			BeneficiaryName: beneficiary,
+			AccountNumber:   r.BankAccountNumber,
+			IFSC:            r.BankIFSC,
+			BankName:        r.BankName,
+			Amount:          r.TotalAmount,
+			Narration:       fmt.Sprintf("Wages period %d", periodID),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
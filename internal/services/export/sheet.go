@@ -0,0 +1,217 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// Sheet receives a table one row at a time so exports never hold the whole
+// result in memory. Cells may be strings, integers or times; anything else
+// is printed with fmt.
+type Sheet interface {
+	WriteRow(cells ...any) error
+	Close() error
+}
+
+// NewSheet returns a writer for the given format.
+func NewSheet(format string, w io.Writer, name string) (Sheet, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVSheet(w), nil
+	case FormatXLSX:
+		return NewXLSXSheet(w, name)
+	default:
+		return nil, errors.New("format must be csv or xlsx")
+	}
+}
+
+// ContentType is the MIME type of the format.
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+func cellText(v any) string {
+	switch c := v.(type) {
+	case string:
+		return c
+	case int:
+		return strconv.Itoa(c)
+	case int64:
+		return strconv.FormatInt(c, 10)
+	case uint:
+		return strconv.FormatUint(uint64(c), 10)
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	case time.Time:
+		return c.Format("2006-01-02")
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(c)
+	}
+}
+
+// ---------------- CSV ----------------
+
+type csvSheet struct {
+	w *csv.Writer
+}
+
+func NewCSVSheet(w io.Writer) Sheet {
+	return &csvSheet{w: csv.NewWriter(w)}
+}
+
+func (s *csvSheet) WriteRow(cells ...any) error {
+	record := make([]string, len(cells))
+	for i, c := range cells {
+		record[i] = cellText(c)
+	}
+	return s.w.Write(record)
+}
+
+func (s *csvSheet) Close() error {
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// ---------------- XLSX ----------------
+// A minimal single-sheet workbook written straight into the zip stream,
+// with inline strings so no shared string table has to be kept.
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+type xlsxSheet struct {
+	zw  *zip.Writer
+	buf *bufio.Writer
+	row int
+}
+
+func NewXLSXSheet(w io.Writer, name string) (Sheet, error) {
+	zw := zip.NewWriter(w)
+
+	var escaped strings.Builder
+	if err := xml.EscapeText(&escaped, []byte(sheetName(name))); err != nil {
+		return nil, err
+	}
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, escaped.String())},
+	}
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(f)
+	if _, err := buf.WriteString(xlsxSheetStart); err != nil {
+		return nil, err
+	}
+
+	return &xlsxSheet{zw: zw, buf: buf}, nil
+}
+
+func (s *xlsxSheet) WriteRow(cells ...any) error {
+	s.row++
+	fmt.Fprintf(s.buf, `<row r="%d">`, s.row)
+
+	for i, c := range cells {
+		ref := columnName(i) + strconv.Itoa(s.row)
+		switch v := c.(type) {
+		case int, int64, uint, float64:
+			fmt.Fprintf(s.buf, `<c r="%s"><v>%s</v></c>`, ref, cellText(v))
+		default:
+			fmt.Fprintf(s.buf, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(s.buf, []byte(cellText(v))); err != nil {
+				return err
+			}
+			s.buf.WriteString(`</t></is></c>`)
+		}
+	}
+
+	_, err := s.buf.WriteString(`</row>`)
+	return err
+}
+
+func (s *xlsxSheet) Close() error {
+	if _, err := s.buf.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := s.buf.Flush(); err != nil {
+		return err
+	}
+	return s.zw.Close()
+}
+
+// columnName turns a zero based index into A, B, ..., Z, AA, AB, ...
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// sheetName trims the name to Excel's limits: 31 characters and none of
+// the characters it rejects.
+func sheetName(name string) string {
+	out := make([]rune, 0, 31)
+	for _, r := range name {
+		switch r {
+		case '\\', '/', '?', '*', '[', ']', ':':
+			r = '_'
+		}
+		out = append(out, r)
+		if len(out) == 31 {
+			break
+		}
+	}
+	if len(out) == 0 {
+		return "Sheet1"
+	}
+	return string(out)
+}
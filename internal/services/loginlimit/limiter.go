@@ -0,0 +1,257 @@
+package loginlimit
+
+import (
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	KindPhone = "phone"
+	KindIP    = "ip"
+)
+
+var ErrInvalidKind = errors.New("kind must be phone or ip")
+
+// Policy decides how failures on one key are punished. The first
+// FreeAttempts failures cost nothing, each later one doubles the wait
+// starting at BaseDelay, and LockAfter failures lock the key for Lockout.
+// The zero Policy turns limiting of that kind off.
+type Policy struct {
+	FreeAttempts int
+	LockAfter    int
+	Lockout      time.Duration
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	// Window forgets failures once the last one is this old.
+	Window time.Duration
+}
+
+// Phone is strict since it targets one account; IP is looser because an
+// office or a mobile carrier puts many workers behind one address. The IP
+// policy is only used when config.LimitByIP says client addresses are real.
+var (
+	DefaultPhonePolicy = Policy{
+		FreeAttempts: 3,
+		LockAfter:    10,
+		Lockout:      15 * time.Minute,
+		BaseDelay:    time.Second,
+		MaxDelay:     5 * time.Minute,
+		Window:       time.Hour,
+	}
+	DefaultIPPolicy = Policy{
+		FreeAttempts: 20,
+		LockAfter:    100,
+		Lockout:      15 * time.Minute,
+		BaseDelay:    time.Second,
+		MaxDelay:     5 * time.Minute,
+		Window:       time.Hour,
+	}
+)
+
+// Limiter throttles password logins per phone number and per IP address.
+type Limiter struct {
+	store Store
+	phone Policy
+	ip    Policy
+}
+
+func NewLimiter(store Store, phone, ip Policy) *Limiter {
+	return &Limiter{store: store, phone: phone, ip: ip}
+}
+
+// Reservation is a login attempt counted as a failure before the password
+// is checked, so concurrent attempts cannot all pass the check before any
+// of them is recorded. A failed attempt needs nothing more.
+type Reservation struct {
+	limiter *Limiter
+	phone   string
+	holds   []hold
+}
+
+// hold is the reserved attempt on one key and the entry it replaced.
+type hold struct {
+	kind  string
+	value string
+	at    time.Time
+	prev  Entry
+}
+
+// Reserve counts an attempt against the phone and the IP. When either key
+// must wait it returns how long instead, and nothing stays counted.
+func (l *Limiter) Reserve(phone, ip string) (*Reservation, time.Duration) {
+	r := &Reservation{limiter: l, phone: phone}
+
+	keys := []struct {
+		kind, value string
+		policy      Policy
+	}{
+		{KindPhone, phone, l.phone},
+		{KindIP, ip, l.ip},
+	}
+	for _, k := range keys {
+		if k.value == "" || k.policy.off() {
+			continue
+		}
+		h, wait, err := l.reserve(k.kind, k.value, k.policy)
+		if err != nil {
+			log.Printf("login limiter: failed to record attempt for %s %s: %v", k.kind, k.value, err)
+			continue
+		}
+		if wait > 0 {
+			r.Cancel()
+			return nil, wait
+		}
+		r.holds = append(r.holds, h)
+	}
+	return r, 0
+}
+
+// Succeed clears the phone's failures. The IP only gets this attempt back
+// so one known password cannot be used to reset spraying from that address.
+func (r *Reservation) Succeed() {
+	if err := r.limiter.store.Delete(KindPhone, r.phone); err != nil {
+		log.Printf("login limiter: failed to clear %s: %v", r.phone, err)
+	}
+	for _, h := range r.holds {
+		if h.kind != KindPhone {
+			r.limiter.release(h)
+		}
+	}
+}
+
+// Cancel gives the attempt back on every key, for requests turned away
+// before the password was checked.
+func (r *Reservation) Cancel() {
+	for _, h := range r.holds {
+		r.limiter.release(h)
+	}
+	r.holds = nil
+}
+
+// Status is an Entry as shown to admins.
+type Status struct {
+	Entry
+	Locked     bool `json:"locked"`
+	RetryAfter int  `json:"retry_after_seconds"`
+}
+
+// Entries lists every tracked key with its current wait.
+func (l *Limiter) Entries() ([]Status, error) {
+	entries, err := l.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list := make([]Status, 0, len(entries))
+	for _, e := range entries {
+		p := l.policy(e.Kind)
+		if e.Failures == 0 && !now.Before(e.LockedUntil) {
+			continue
+		}
+		if now.Sub(e.LastFailure) > p.Window && !now.Before(e.LockedUntil) {
+			continue
+		}
+		list = append(list, Status{
+			Entry:      e,
+			Locked:     now.Before(e.LockedUntil),
+			RetryAfter: int(p.wait(e, now).Round(time.Second).Seconds()),
+		})
+	}
+	return list, nil
+}
+
+// Clear removes the lockout and failures of one phone or IP.
+func (l *Limiter) Clear(kind, value string) error {
+	if kind != KindPhone && kind != KindIP {
+		return ErrInvalidKind
+	}
+	return l.store.Delete(kind, value)
+}
+
+func (l *Limiter) policy(kind string) Policy {
+	if kind == KindIP {
+		return l.ip
+	}
+	return l.phone
+}
+
+// reserve checks and counts one attempt on a key in a single store update.
+func (l *Limiter) reserve(kind, value string, p Policy) (hold, time.Duration, error) {
+	now := time.Now()
+	h := hold{kind: kind, value: value, at: now}
+	wait := time.Duration(0)
+	locked := false
+
+	e, err := l.store.Update(kind, value, func(e *Entry) {
+		if wait = p.wait(*e, now); wait > 0 {
+			return
+		}
+		h.prev = *e
+
+		if now.Sub(e.LastFailure) > p.Window {
+			e.Failures = 0
+		}
+		e.Failures++
+		e.LastFailure = now
+
+		if e.Failures >= p.LockAfter {
+			// a fresh set of attempts once the lockout ends
+			e.LockedUntil = now.Add(p.Lockout)
+			e.Failures = 0
+			locked = true
+		}
+	})
+	if err != nil {
+		return h, 0, err
+	}
+	if locked {
+		log.Printf("login limiter: %s %s locked until %s", kind, value, e.LockedUntil.Format(time.RFC3339))
+	}
+	return h, wait, nil
+}
+
+// release undoes a reserved attempt. When nothing was counted on the key
+// since, the entry goes back to what it was, lockout included.
+func (l *Limiter) release(h hold) {
+	_, err := l.store.Update(h.kind, h.value, func(e *Entry) {
+		if e.LastFailure.Equal(h.at) {
+			*e = h.prev
+			return
+		}
+		if e.Failures > 0 {
+			e.Failures--
+		}
+	})
+	if err != nil {
+		log.Printf("login limiter: failed to release attempt for %s %s: %v", h.kind, h.value, err)
+	}
+}
+
+func (p Policy) off() bool {
+	return p.LockAfter <= 0
+}
+
+func (p Policy) wait(e Entry, now time.Time) time.Duration {
+	if now.Before(e.LockedUntil) {
+		return e.LockedUntil.Sub(now)
+	}
+	if now.Sub(e.LastFailure) > p.Window || e.Failures <= p.FreeAttempts {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.FreeAttempts + 1; i < e.Failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	until := e.LastFailure.Add(delay)
+	if !now.Before(until) {
+		return 0
+	}
+	return until.Sub(now)
+}
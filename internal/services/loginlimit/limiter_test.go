@@ -0,0 +1,291 @@
+package loginlimit
+
+import (
+	"testing"
+	"time"
+)
+
+var testPolicy = Policy{
+	FreeAttempts: 2,
+	LockAfter:    4,
+	Lockout:      time.Hour,
+	BaseDelay:    time.Minute,
+	MaxDelay:     10 * time.Minute,
+	Window:       time.Hour,
+}
+
+const (
+	testPhone = "9999999999"
+	testIP    = "203.0.113.7"
+)
+
+func seed(t *testing.T, s *MemoryStore, kind, value string, e Entry) {
+	t.Helper()
+	if _, err := s.Update(kind, value, func(cur *Entry) {
+		cur.Failures = e.Failures
+		cur.LastFailure = e.LastFailure
+		cur.LockedUntil = e.LockedUntil
+	}); err != nil {
+		t.Fatalf("seed %s %s: %v", kind, value, err)
+	}
+}
+
+func lookup(t *testing.T, s *MemoryStore, kind, value string) (Entry, bool) {
+	t.Helper()
+	list, err := s.List()
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	for _, e := range list {
+		if e.Kind == kind && e.Value == value {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+func failures(t *testing.T, s *MemoryStore, kind, value string) int {
+	t.Helper()
+	e, _ := lookup(t, s, kind, value)
+	return e.Failures
+}
+
+func TestLimiterReserve(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		ipPolicy     Policy
+		ip           string
+		phoneSeed    *Entry
+		ipSeed       *Entry
+		wantWait     bool
+		wantPhone    int
+		wantIP       int
+		wantLocked   bool
+		wantIPStored bool
+	}{
+		{
+			name:         "first attempt counts on both keys",
+			ipPolicy:     testPolicy,
+			ip:           testIP,
+			wantPhone:    1,
+			wantIP:       1,
+			wantIPStored: true,
+		},
+		{
+			name:         "last free attempt passes",
+			ipPolicy:     testPolicy,
+			ip:           testIP,
+			phoneSeed:    &Entry{Failures: 2, LastFailure: now},
+			wantPhone:    3,
+			wantIP:       1,
+			wantIPStored: true,
+		},
+		{
+			name:      "phone past free attempts waits and ip is untouched",
+			ipPolicy:  testPolicy,
+			ip:        testIP,
+			phoneSeed: &Entry{Failures: 3, LastFailure: now},
+			wantWait:  true,
+			wantPhone: 3,
+		},
+		{
+			name:       "locked phone waits",
+			ipPolicy:   testPolicy,
+			ip:         testIP,
+			phoneSeed:  &Entry{LockedUntil: now.Add(time.Minute)},
+			wantWait:   true,
+			wantLocked: true,
+		},
+		{
+			name:         "ip waiting gives the phone its attempt back",
+			ipPolicy:     testPolicy,
+			ip:           testIP,
+			phoneSeed:    &Entry{Failures: 1, LastFailure: now.Add(-time.Minute)},
+			ipSeed:       &Entry{Failures: 3, LastFailure: now},
+			wantWait:     true,
+			wantPhone:    1,
+			wantIP:       3,
+			wantIPStored: true,
+		},
+		{
+			name:         "failures older than the window are forgotten",
+			ipPolicy:     testPolicy,
+			ip:           testIP,
+			phoneSeed:    &Entry{Failures: 3, LastFailure: now.Add(-2 * time.Hour)},
+			wantPhone:    1,
+			wantIP:       1,
+			wantIPStored: true,
+		},
+		{
+			name:         "reaching LockAfter locks the phone",
+			ipPolicy:     testPolicy,
+			ip:           testIP,
+			phoneSeed:    &Entry{Failures: 3, LastFailure: now.Add(-5 * time.Minute)},
+			wantPhone:    0,
+			wantIP:       1,
+			wantLocked:   true,
+			wantIPStored: true,
+		},
+		{
+			name:      "zero ip policy leaves the ip alone",
+			ip:        testIP,
+			wantPhone: 1,
+		},
+		{
+			name:      "empty ip is skipped",
+			ipPolicy:  testPolicy,
+			wantPhone: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			if tt.phoneSeed != nil {
+				seed(t, store, KindPhone, testPhone, *tt.phoneSeed)
+			}
+			if tt.ipSeed != nil {
+				seed(t, store, KindIP, tt.ip, *tt.ipSeed)
+			}
+			l := NewLimiter(store, testPolicy, tt.ipPolicy)
+
+			r, wait := l.Reserve(testPhone, tt.ip)
+			if tt.wantWait {
+				if wait <= 0 || r != nil {
+					t.Fatalf("Reserve() = %v, %v; want a wait and no reservation", r, wait)
+				}
+			} else if wait != 0 || r == nil {
+				t.Fatalf("Reserve() = %v, %v; want a reservation", r, wait)
+			}
+
+			if got := failures(t, store, KindPhone, testPhone); got != tt.wantPhone {
+				t.Errorf("phone failures = %d, want %d", got, tt.wantPhone)
+			}
+			ipEntry, ok := lookup(t, store, KindIP, tt.ip)
+			if ok != tt.wantIPStored {
+				t.Fatalf("ip entry stored = %v, want %v", ok, tt.wantIPStored)
+			}
+			if ipEntry.Failures != tt.wantIP {
+				t.Errorf("ip failures = %d, want %d", ipEntry.Failures, tt.wantIP)
+			}
+			phone, _ := lookup(t, store, KindPhone, testPhone)
+			if locked := time.Now().Before(phone.LockedUntil); locked != tt.wantLocked {
+				t.Errorf("phone locked = %v, want %v", locked, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestReservationSucceed(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		phoneSeed *Entry
+		ipSeed    *Entry
+		wantIP    int
+	}{
+		{
+			name:   "fresh keys",
+			wantIP: 0,
+		},
+		{
+			name:      "clears phone failures but keeps earlier ip failures",
+			phoneSeed: &Entry{Failures: 2, LastFailure: now.Add(-time.Minute)},
+			ipSeed:    &Entry{Failures: 2, LastFailure: now.Add(-time.Minute)},
+			wantIP:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			if tt.phoneSeed != nil {
+				seed(t, store, KindPhone, testPhone, *tt.phoneSeed)
+			}
+			if tt.ipSeed != nil {
+				seed(t, store, KindIP, testIP, *tt.ipSeed)
+			}
+			l := NewLimiter(store, testPolicy, testPolicy)
+
+			r, wait := l.Reserve(testPhone, testIP)
+			if r == nil {
+				t.Fatalf("Reserve() waited %v", wait)
+			}
+			r.Succeed()
+
+			if _, ok := lookup(t, store, KindPhone, testPhone); ok {
+				t.Errorf("phone entry kept after success")
+			}
+			if got := failures(t, store, KindIP, testIP); got != tt.wantIP {
+				t.Errorf("ip failures = %d, want %d", got, tt.wantIP)
+			}
+		})
+	}
+}
+
+func TestReservationCancel(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		seed Entry
+		// between is counted on the phone after the reservation and
+		// before it is cancelled.
+		between    bool
+		wantPhone  int
+		wantLocked bool
+	}{
+		{
+			name:      "restores earlier failures",
+			seed:      Entry{Failures: 1, LastFailure: now.Add(-time.Minute)},
+			wantPhone: 1,
+		},
+		{
+			name:      "undoes the lockout the attempt caused",
+			seed:      Entry{Failures: 3, LastFailure: now.Add(-5 * time.Minute)},
+			wantPhone: 3,
+		},
+		{
+			name:      "only takes one failure back after a later attempt",
+			seed:      Entry{Failures: 1, LastFailure: now.Add(-time.Minute)},
+			between:   true,
+			wantPhone: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			seed(t, store, KindPhone, testPhone, tt.seed)
+			l := NewLimiter(store, testPolicy, testPolicy)
+
+			r, wait := l.Reserve(testPhone, testIP)
+			if r == nil {
+				t.Fatalf("Reserve() waited %v", wait)
+			}
+			if tt.between {
+				if _, err := store.Update(KindPhone, testPhone, func(e *Entry) {
+					e.Failures++
+					e.LastFailure = time.Now().Add(time.Second)
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			r.Cancel()
+
+			phone, _ := lookup(t, store, KindPhone, testPhone)
+			if phone.Failures != tt.wantPhone {
+				t.Errorf("phone failures = %d, want %d", phone.Failures, tt.wantPhone)
+			}
+			if locked := time.Now().Before(phone.LockedUntil); locked != tt.wantLocked {
+				t.Errorf("phone locked = %v, want %v", locked, tt.wantLocked)
+			}
+			if got := failures(t, store, KindIP, testIP); got != 0 {
+				t.Errorf("ip failures = %d, want 0", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package loginlimit
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Entry is the failed-login state of one phone number or IP address.
+type Entry struct {
+	Kind        string    `json:"kind"`
+	Value       string    `json:"value"`
+	Failures    int       `json:"failures"`
+	LastFailure time.Time `json:"last_failure"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+// Store keeps limiter entries. MemoryStore covers a single instance; running
+// several instances needs a shared implementation (database or cache) so
+// they see the same counters. Update must apply fn atomically per key.
+type Store interface {
+	// Update loads the entry (zero Entry with Kind and Value set when new),
+	// lets fn change it and saves the result.
+	Update(kind, value string, fn func(*Entry)) (Entry, error)
+	Delete(kind, value string) error
+	List() ([]Entry, error)
+}
+
+const (
+	// sweepAfter is how long an untouched, unlocked entry is kept. No
+	// default policy remembers failures for longer.
+	sweepAfter = time.Hour
+	// sweepInterval is how often Update drops stale entries.
+	sweepInterval = time.Minute
+	// maxEntries caps the store so spraying random phone numbers cannot
+	// grow it without bound. When full, the least recently failed tenth
+	// is evicted, unlocked entries first.
+	maxEntries = 50000
+)
+
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]Entry
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]Entry)}
+}
+
+func (s *MemoryStore) Update(kind, value string, fn func(*Entry)) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+		s.lastSweep = now
+	}
+
+	key := kind + ":" + value
+	e, ok := s.entries[key]
+	if !ok {
+		if len(s.entries) >= maxEntries {
+			s.evict(now)
+		}
+		e = Entry{Kind: kind, Value: value}
+	}
+	fn(&e)
+
+	s.entries[key] = e
+	return e, nil
+}
+
+func (s *MemoryStore) Delete(kind, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, kind+":"+value)
+	return nil
+}
+
+func (s *MemoryStore) List() ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].LastFailure.After(list[j].LastFailure)
+	})
+	return list, nil
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, e := range s.entries {
+		if now.After(e.LockedUntil) && now.Sub(e.LastFailure) > sweepAfter {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// evict drops the least recently failed tenth of the entries, keeping
+// locked ones as long as there are unlocked ones to drop.
+func (s *MemoryStore) evict(now time.Time) {
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := s.entries[keys[i]], s.entries[keys[j]]
+		aLocked, bLocked := now.Before(a.LockedUntil), now.Before(b.LockedUntil)
+		if aLocked != bLocked {
+			return bLocked
+		}
+		return a.LastFailure.Before(b.LastFailure)
+	})
+
+	for _, key := range keys[:len(keys)/10+1] {
+		delete(s.entries, key)
+	}
+}
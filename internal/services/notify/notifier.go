@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"errors"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/sms"
+)
+
+// Notifier delivers account messages (password resets and the like) to a
+// user. SMS is the only channel today since users have no email address.
+type Notifier interface {
+	Notify(user *models.User, message string) error
+}
+
+// SMSNotifier sends the message to the user's phone.
+type SMSNotifier struct {
+	sender sms.SMSSender
+}
+
+func NewSMSNotifier(sender sms.SMSSender) *SMSNotifier {
+	return &SMSNotifier{sender: sender}
+}
+
+func (n *SMSNotifier) Notify(user *models.User, message string) error {
+	if user.Phone == "" {
+		return errors.New("user has no phone number")
+	}
+	return n.sender.Send(user.Phone, message)
+}
@@ -0,0 +1,65 @@
+package payslip
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/models"
+)
+
+// Payslip is everything printed on one PDF.
+type Payslip struct {
+	Title         string
+	PeriodLabel   string
+	Worker        models.User
+	Lines         []Line
+	PaymentStatus string
+	Reference     string
+	PaidAt        *time.Time
+	GeneratedAt   time.Time
+}
+
+// Line is one booking on the payslip.
+type Line struct {
+	Date        time.Time
+	EventName   string
+	Role        string
+	BaseAmount  int64
+	ExtraAmount int64
+	TAAmount    int64
+	BonusAmount int64
+	FineAmount  int64
+	// advance recovered from this booking, already taken off TotalAmount
+	AdvanceDeduction int64
+	TotalAmount      int64
+}
+
+// Totals sums every component over the payslip lines.
+func (p *Payslip) Totals() Line {
+	var t Line
+	for _, l := range p.Lines {
+		t.BaseAmount += l.BaseAmount
+		t.ExtraAmount += l.ExtraAmount
+		t.TAAmount += l.TAAmount
+		t.BonusAmount += l.BonusAmount
+		t.FineAmount += l.FineAmount
+		t.AdvanceDeduction += l.AdvanceDeduction
+		t.TotalAmount += l.TotalAmount
+	}
+	return t
+}
+
+func lineFromBooking(b models.Booking) Line {
+	return Line{
+		Date:        b.Event.Date,
+		EventName:   b.Event.EventName,
+		Role:        b.Role,
+		BaseAmount:  b.BaseAmount,
+		ExtraAmount: b.ExtraAmount,
+		TAAmount:    b.TAAmount,
+		BonusAmount: b.BonusAmount,
+		FineAmount:  b.FineAmount,
+		TotalAmount: b.TotalAmount,
+
+		AdvanceDeduction: b.AdvanceDeduction,
+	}
+}
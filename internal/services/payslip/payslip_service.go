@@ -0,0 +1,174 @@
+package payslip
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+)
+
+// PayslipService builds payslips from payroll payouts or single completed
+// bookings. It is shared by the worker and admin endpoints.
+type PayslipService struct {
+	payrollRepo interfaces.PayrollRepository
+	bookingRepo interfaces.BookingRepository
+	eventRepo   interfaces.EventRepository
+	userRepo    interfaces.UserRepository
+}
+
+func NewPayslipService(
+	payrollRepo interfaces.PayrollRepository,
+	bookingRepo interfaces.BookingRepository,
+	eventRepo interfaces.EventRepository,
+	userRepo interfaces.UserRepository,
+) *PayslipService {
+	return &PayslipService{
+		payrollRepo: payrollRepo,
+		bookingRepo: bookingRepo,
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// ---------------- PER PERIOD ----------------
+
+func (s *PayslipService) ForPeriod(userID, periodID uint) (*Payslip, error) {
+	period, err := s.payrollRepo.FindPeriodByID(periodID)
+	if err != nil {
+		return nil, errors.New("payroll period not found")
+	}
+	if period.Status != models.PayrollPeriodClosed {
+		return nil, errors.New("payroll period is not closed yet")
+	}
+
+	payout, err := s.payrollRepo.FindPayoutByPeriodAndUser(periodID, userID)
+	if err != nil {
+		return nil, errors.New("no payslip for this period")
+	}
+
+	return s.fromPayout(period, payout)
+}
+
+func (s *PayslipService) fromPayout(period *models.PayrollPeriod, payout *models.Payout) (*Payslip, error) {
+	user, err := s.userRepo.FindByID(payout.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	bookings, err := s.bookingRepo.ListByPayout(payout.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	slip := &Payslip{
+		Title:         period.Name,
+		PeriodLabel:   period.StartDate.Format("02 Jan 2006") + " - " + period.EndDate.Format("02 Jan 2006"),
+		Worker:        *user,
+		PaymentStatus: models.PaymentStatusUnpaid,
+		GeneratedAt:   time.Now(),
+	}
+	if payout.Status == models.PayoutStatusPaid {
+		slip.PaymentStatus = models.PaymentStatusPaid
+		slip.Reference = payout.Reference
+		slip.PaidAt = payout.PaidAt
+	}
+	for _, b := range bookings {
+		slip.Lines = append(slip.Lines, lineFromBooking(b))
+	}
+
+	return slip, nil
+}
+
+// ---------------- PER EVENT ----------------
+
+func (s *PayslipService) ForEvent(userID, eventID uint) (*Payslip, error) {
+	booking, err := s.bookingRepo.FindByEventAndUser(eventID, userID)
+	if err != nil {
+		return nil, errors.New("booking not found")
+	}
+	if booking.Status != models.BookingStatusCompleted {
+		return nil, errors.New("payslip is available only for completed bookings")
+	}
+
+	event, err := s.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	booking.Event = *event
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	slip := &Payslip{
+		Title:         event.EventName,
+		PeriodLabel:   event.Date.Format("02 Jan 2006"),
+		Worker:        *user,
+		Lines:         []Line{lineFromBooking(*booking)},
+		PaymentStatus: models.PaymentStatusUnpaid,
+		GeneratedAt:   time.Now(),
+	}
+
+	if booking.PayoutID != nil {
+		if payout, err := s.payrollRepo.FindPayoutByID(*booking.PayoutID); err == nil &&
+			payout.Status == models.PayoutStatusPaid {
+			slip.PaymentStatus = models.PaymentStatusPaid
+			slip.Reference = payout.Reference
+			slip.PaidAt = payout.PaidAt
+		}
+	}
+
+	return slip, nil
+}
+
+// ---------------- BULK ZIP ----------------
+
+// WritePeriodZip streams one PDF per payout of a closed period into a zip.
+func (s *PayslipService) WritePeriodZip(w io.Writer, periodID uint) error {
+	period, err := s.payrollRepo.FindPeriodByID(periodID)
+	if err != nil {
+		return errors.New("payroll period not found")
+	}
+	if period.Status != models.PayrollPeriodClosed {
+		return errors.New("payroll period is not closed yet")
+	}
+
+	payouts, err := s.payrollRepo.ListPayouts(periodID, "")
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for i := range payouts {
+		slip, err := s.fromPayout(period, &payouts[i])
+		if err != nil {
+			return err
+		}
+
+		f, err := zw.Create(FileName(slip, fmt.Sprintf("period_%d", period.ID)))
+		if err != nil {
+			return err
+		}
+		if err := Render(f, slip); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// CheckPeriodClosed lets handlers fail before the zip response has started.
+func (s *PayslipService) CheckPeriodClosed(periodID uint) error {
+	period, err := s.payrollRepo.FindPeriodByID(periodID)
+	if err != nil {
+		return errors.New("payroll period not found")
+	}
+	if period.Status != models.PayrollPeriodClosed {
+		return errors.New("payroll period is not closed yet")
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package payslip
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"event-management-backend/internal/domain/models"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// PhotoDir is where user photos are uploaded.
+const PhotoDir = "uploads/users"
+
+var columns = []struct {
+	title string
+	width float64
+}{
+	{"Date", 22},
+	{"Event", 30},
+	{"Base", 20},
+	{"Extra", 18},
+	{"TA", 18},
+	{"Bonus", 18},
+	{"Fine", 18},
+	{"Advance", 18},
+	{"Total", 24},
+}
+
+// Render writes the payslip as an A4 PDF.
+func Render(w io.Writer, slip *Payslip) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(12, 12, 12)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+
+	// ---------------- HEADER ----------------
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 9, "Payslip", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, slip.Title, "", 1, "L", false, 0, "")
+	if slip.PeriodLabel != "" {
+		pdf.CellFormat(0, 6, slip.PeriodLabel, "", 1, "L", false, 0, "")
+	}
+
+	// ---------------- WORKER ----------------
+	top := pdf.GetY() + 4
+	drawPhoto(pdf, slip.Worker.Photo, 168, 12)
+
+	pdf.SetY(top)
+	worker := [][2]string{
+		{"Name", slip.Worker.Name},
+		{"Phone", slip.Worker.Phone},
+		{"Role", models.RoleLabel(slip.Worker.Role)},
+		{"Branch", slip.Worker.Branch},
+	}
+	for _, row := range worker {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(25, 6, row[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(0, 6, row[1], "", 1, "L", false, 0, "")
+	}
+
+	pdf.SetY(pdf.GetY() + 6)
+
+	// ---------------- LINES ----------------
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetFillColor(230, 230, 230)
+	for _, col := range columns {
+		align := "R"
+		if col.title == "Date" || col.title == "Event" {
+			align = "L"
+		}
+		pdf.CellFormat(col.width, 7, col.title, "1", 0, align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, l := range slip.Lines {
+		cells := []string{
+			l.Date.Format("02 Jan 2006"),
+			truncate(l.EventName, 17),
+			money(l.BaseAmount),
+			money(l.ExtraAmount),
+			money(l.TAAmount),
+			money(l.BonusAmount),
+			fine(l.FineAmount),
+			fine(l.AdvanceDeduction),
+			money(l.TotalAmount),
+		}
+		writeRow(pdf, cells, false)
+	}
+
+	t := slip.Totals()
+	pdf.SetFont("Helvetica", "B", 9)
+	writeRow(pdf, []string{
+		"", "Total",
+		money(t.BaseAmount),
+		money(t.ExtraAmount),
+		money(t.TAAmount),
+		money(t.BonusAmount),
+		fine(t.FineAmount),
+		fine(t.AdvanceDeduction),
+		money(t.TotalAmount),
+	}, true)
+
+	// ---------------- PAYMENT ----------------
+	pdf.SetY(pdf.GetY() + 8)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 7, "Net payable: Rs. "+money(t.TotalAmount), "", 1, "L", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	status := "Payment status: " + slip.PaymentStatus
+	if slip.PaidAt != nil {
+		status += " on " + slip.PaidAt.Format("02 Jan 2006")
+	}
+	if slip.Reference != "" {
+		status += " (ref " + slip.Reference + ")"
+	}
+	pdf.CellFormat(0, 6, status, "", 1, "L", false, 0, "")
+
+	pdf.SetY(pdf.GetY() + 6)
+	pdf.SetFont("Helvetica", "I", 8)
+	pdf.CellFormat(0, 5, "Generated "+slip.GeneratedAt.Format("02 Jan 2006 15:04"), "", 1, "L", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+func writeRow(pdf *fpdf.Fpdf, cells []string, fill bool) {
+	for i, col := range columns {
+		align := "R"
+		if i < 2 {
+			align = "L"
+		}
+		pdf.CellFormat(col.width, 6, cells[i], "1", 0, align, fill, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// drawPhoto places the user photo in the top right corner. A missing or
+// unreadable photo is skipped rather than failing the payslip.
+func drawPhoto(pdf *fpdf.Fpdf, photo string, x, y float64) {
+	if photo == "" {
+		return
+	}
+
+	path := filepath.Join(PhotoDir, filepath.Base(photo))
+	if _, err := os.Stat(path); err != nil {
+		return
+	}
+
+	imageType := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if imageType == "jpeg" {
+		imageType = "jpg"
+	}
+	if imageType != "jpg" && imageType != "png" {
+		return
+	}
+
+	pdf.ImageOptions(path, x, y, 30, 0, false, fpdf.ImageOptions{ImageType: imageType}, 0, "")
+	if pdf.Err() {
+		pdf.ClearError()
+	}
+}
+
+// money formats an amount with thousands separators, e.g. 12500 -> "12,500".
+func money(v int64) string {
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+
+	s := strconv.FormatInt(v, 10)
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+
+	if neg {
+		return "-" + b.String()
+	}
+	return b.String()
+}
+
+// fine shows deductions with a leading minus.
+func fine(v int64) string {
+	if v == 0 {
+		return "0"
+	}
+	return "-" + money(v)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "."
+}
+
+// FileName is the name used for downloads and zip entries.
+func FileName(slip *Payslip, suffix string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == ' ' {
+			return '_'
+		}
+		return r
+	}, strings.ToLower(slip.Worker.Name))
+	return fmt.Sprintf("payslip_%s_%d_%s.pdf", name, slip.Worker.ID, suffix)
+}
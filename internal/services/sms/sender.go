@@ -0,0 +1,83 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// SMSSender delivers a text message to a 10-digit phone number. A real
+// gateway only has to implement this.
+type SMSSender interface {
+	Send(phone, message string) error
+}
+
+// NewSenderFromEnv picks the sender from SMS_PROVIDER: "console" logs the
+// message, "file" appends to SMS_FILE (default sms.log). Both leave login
+// codes and reset tokens readable in clear, so they are for development
+// only and refused when APP_ENV is production. There is no default; an
+// unset SMS_PROVIDER is an error so no deployment falls back silently.
+func NewSenderFromEnv() (SMSSender, error) {
+	provider := os.Getenv("SMS_PROVIDER")
+	switch provider {
+	case "":
+		return nil, errors.New("SMS_PROVIDER is not set")
+	case "console", "file":
+	default:
+		return nil, fmt.Errorf("unknown SMS_PROVIDER %q", provider)
+	}
+
+	if os.Getenv("APP_ENV") == "production" {
+		return nil, fmt.Errorf("SMS_PROVIDER %q writes codes in clear and is not allowed in production", provider)
+	}
+	log.Printf("WARNING: SMS_PROVIDER=%s writes login codes and reset tokens in clear, use for development only", provider)
+
+	if provider == "file" {
+		path := os.Getenv("SMS_FILE")
+		if path == "" {
+			path = "sms.log"
+		}
+		return NewFileSender(path), nil
+	}
+	return NewConsoleSender(), nil
+}
+
+// ConsoleSender prints messages to the server log, for development.
+type ConsoleSender struct{}
+
+func NewConsoleSender() *ConsoleSender {
+	return &ConsoleSender{}
+}
+
+func (s *ConsoleSender) Send(phone, message string) error {
+	log.Printf("sms to %s: %s", phone, message)
+	return nil
+}
+
+// FileSender appends one line per message to a file, so tests and local
+// setups can read the code back without a gateway.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(phone, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), phone, message)
+	return err
+}
@@ -0,0 +1,198 @@
+package travel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+const defaultRoadFactorPercent = 130
+
+// Proposal is the travel allowance the system suggests for one booking.
+type Proposal struct {
+	Amount     int64   `json:"amount"`
+	PolicyID   uint    `json:"policy_id"`
+	DistanceKm float64 `json:"distance_km"`
+	Rule       string  `json:"rule"`
+}
+
+// TACalculator proposes travel allowance from the user's starting point to
+// the venue. Distances are computed offline from stored coordinates.
+type TACalculator struct {
+	pointRepo   interfaces.StartingPointRepository
+	policyRepo  interfaces.TAPolicyRepository
+	settingRepo interfaces.SettingRepository
+	eventRepo   interfaces.EventRepository
+	bookingRepo interfaces.BookingRepository
+	userRepo    interfaces.UserRepository
+}
+
+func NewTACalculator(
+	pointRepo interfaces.StartingPointRepository,
+	policyRepo interfaces.TAPolicyRepository,
+	settingRepo interfaces.SettingRepository,
+	eventRepo interfaces.EventRepository,
+	bookingRepo interfaces.BookingRepository,
+	userRepo interfaces.UserRepository,
+) *TACalculator {
+	return &TACalculator{
+		pointRepo:   pointRepo,
+		policyRepo:  policyRepo,
+		settingRepo: settingRepo,
+		eventRepo:   eventRepo,
+		bookingRepo: bookingRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// ProposeTx returns the allowance the policy for the event's transport type
+// gives for the user's trip, or an error saying what is missing.
+func (c *TACalculator) ProposeTx(tx *gorm.DB, user *models.User, event *models.Event) (*Proposal, error) {
+	if !event.HasLocation() {
+		return nil, errors.New("event location is not set")
+	}
+
+	if user.StartingPoint == "" {
+		return nil, errors.New("user has no starting point")
+	}
+	point, err := c.pointRepo.FindByNameTx(tx, user.StartingPoint)
+	if err != nil {
+		return nil, fmt.Errorf("starting point %q is not configured", user.StartingPoint)
+	}
+
+	transport := models.TransportOwn
+	if event.TransportProvided && event.TransportType != "" {
+		transport = event.TransportType
+	}
+	policy, err := c.policyRepo.FindByTransportTx(tx, transport)
+	if err != nil {
+		return nil, fmt.Errorf("no TA policy for %s transport", transport)
+	}
+
+	factor := c.settingRepo.GetInt(models.SettingTARoadFactorPercent, defaultRoadFactorPercent)
+	if factor < 100 {
+		factor = 100
+	}
+
+	meters := utils.DistanceMeters(point.Latitude, point.Longitude, *event.Latitude, *event.Longitude)
+	km := math.Round(meters*float64(factor)/100/100) / 10
+
+	return &Proposal{
+		Amount:     policy.Amount(km),
+		PolicyID:   policy.ID,
+		DistanceKm: km,
+		Rule:       policy.Describe(km, point.Name),
+	}, nil
+}
+
+// ApplyTx sets the booking TA. A manual amount always wins and is kept on
+// later updates; otherwise the proposal is used, or zero when none exists.
+func (c *TACalculator) ApplyTx(
+	tx *gorm.DB,
+	booking *models.Booking,
+	user *models.User,
+	event *models.Event,
+	manual *int64,
+) {
+	if manual != nil {
+		booking.TAAmount = *manual
+		booking.TAPolicyID = nil
+		booking.TARule = models.TARuleManual
+		booking.TAOverridden = true
+		return
+	}
+
+	if booking.TAOverridden {
+		return
+	}
+
+	proposal, err := c.ProposeTx(tx, user, event)
+	if err != nil {
+		booking.TAAmount = 0
+		booking.TAPolicyID = nil
+		booking.TADistanceKm = nil
+		booking.TARule = ""
+		return
+	}
+
+	booking.TAAmount = proposal.Amount
+	booking.TAPolicyID = &proposal.PolicyID
+	booking.TADistanceKm = &proposal.DistanceKm
+	booking.TARule = proposal.Rule
+}
+
+// BookingProposal pairs a booking with its proposed TA, or the reason no
+// proposal could be made.
+type BookingProposal struct {
+	BookingID    uint      `json:"booking_id"`
+	UserID       uint      `json:"user_id"`
+	Name         string    `json:"name"`
+	Role         string    `json:"role"`
+	TAAmount     int64     `json:"ta_amount"`
+	TAOverridden bool      `json:"ta_overridden"`
+	Proposal     *Proposal `json:"proposal"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// ProposeForEvent lists the proposed TA of every booking of the event so
+// captains and admins can review them before marking attendance.
+func (c *TACalculator) ProposeForEvent(eventID uint) ([]BookingProposal, error) {
+	event, err := c.eventRepo.FindByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	bookings, err := c.bookingRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, 0, len(bookings))
+	for _, b := range bookings {
+		userIDs = append(userIDs, b.UserID)
+	}
+	list, err := c.userRepo.FindByIDs(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[uint]*models.User, len(list))
+	for i := range list {
+		users[list[i].ID] = &list[i]
+	}
+
+	res := make([]BookingProposal, 0, len(bookings))
+	for _, b := range bookings {
+		row := BookingProposal{
+			BookingID:    b.ID,
+			UserID:       b.UserID,
+			Role:         b.Role,
+			TAAmount:     b.TAAmount,
+			TAOverridden: b.TAOverridden,
+		}
+
+		user, ok := users[b.UserID]
+		if !ok {
+			row.Error = "user not found"
+			res = append(res, row)
+			continue
+		}
+		row.Name = user.Name
+
+		proposal, err := c.ProposeTx(config.DB, user, event)
+		if err != nil {
+			row.Error = err.Error()
+		} else {
+			row.Proposal = proposal
+		}
+		res = append(res, row)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,190 @@
+package wages
+
+import (
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// WageResolver decides the base wage of a booking and the current wage of a
+// user. All booking and wage code goes through it so the rules live in one
+// place.
+type WageResolver struct {
+	revisionRepo interfaces.RoleWageRevisionRepository
+	tierRepo     interfaces.WageTierRepository
+	logRepo      interfaces.WageChangeLogRepository
+	overrideRepo interfaces.WageOverrideRepository
+}
+
+func NewWageResolver(
+	revisionRepo interfaces.RoleWageRevisionRepository,
+	tierRepo interfaces.WageTierRepository,
+	logRepo interfaces.WageChangeLogRepository,
+	overrideRepo interfaces.WageOverrideRepository,
+) *WageResolver {
+	return &WageResolver{
+		revisionRepo: revisionRepo,
+		tierRepo:     tierRepo,
+		logRepo:      logRepo,
+		overrideRepo: overrideRepo,
+	}
+}
+
+// BaseWage returns the wage of the user's booked role as it stood on the
+// event date, after seniority and any wage override in force on that date.
+// Without any revision history it falls back to CurrentWage.
+func (r *WageResolver) BaseWage(tx *gorm.DB, user *models.User, role string, eventDate time.Time) int64 {
+	revision, err := r.revisionRepo.FindEffectiveTx(tx, role, eventDate)
+	if err != nil {
+		return user.CurrentWage
+	}
+	return r.WageFor(tx, user, role, revision.Wage, eventDate)
+}
+
+// WageFor applies the seniority increment and the user's wage override
+// active at t to the role wage base. Overrides take precedence over both.
+func (r *WageResolver) WageFor(tx *gorm.DB, user *models.User, role string, base int64, t time.Time) int64 {
+	var increment int64
+	if base > 0 {
+		increment = r.SeniorityIncrement(tx, role, user.CompletedWork)
+	}
+
+	var override *models.WageOverride
+	if user.ID != 0 {
+		if o, err := r.overrideRepo.FindActiveTx(tx, user.ID, t); err == nil {
+			override = o
+		}
+	}
+	return applyWage(base, increment, override)
+}
+
+// SeniorityIncrement returns the increment of the highest tier reached.
+func (r *WageResolver) SeniorityIncrement(tx *gorm.DB, role string, completedWork uint) int64 {
+	tiers, err := r.tierRepo.ListByRoleTx(tx, role)
+	if err != nil {
+		return 0
+	}
+	return incrementFor(tiers, completedWork)
+}
+
+// SyncRoleTx recomputes CurrentWage for every user of role.
+func (r *WageResolver) SyncRoleTx(tx *gorm.DB, role, source, reason string) error {
+	var userIDs []uint
+	if err := tx.
+		Model(&models.User{}).
+		Where("role = ? AND deleted_at IS NULL", role).
+		Pluck("id", &userIDs).Error; err != nil {
+		return err
+	}
+	return r.SyncUsersTx(tx, userIDs, source, reason)
+}
+
+// SyncUsersTx recomputes CurrentWage from the role wage, seniority and wage
+// override of the given users and logs every change. Users outside the
+// staff roles earn no wage.
+func (r *WageResolver) SyncUsersTx(tx *gorm.DB, userIDs []uint, source, reason string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	var users []models.User
+	if err := tx.
+		Where("id IN ? AND deleted_at IS NULL", userIDs).
+		Find(&users).Error; err != nil {
+		return err
+	}
+
+	var roleWages []models.RoleWage
+	if err := tx.Find(&roleWages).Error; err != nil {
+		return err
+	}
+	base := make(map[string]int64, len(roleWages))
+	for _, w := range roleWages {
+		base[w.Role] = w.Wage
+	}
+
+	// latest start first, so the first one per user wins
+	overrides, err := r.overrideRepo.ListActiveByUsersTx(tx, userIDs, time.Now())
+	if err != nil {
+		return err
+	}
+	active := make(map[uint]*models.WageOverride, len(overrides))
+	for i := range overrides {
+		if _, ok := active[overrides[i].UserID]; !ok {
+			active[overrides[i].UserID] = &overrides[i]
+		}
+	}
+
+	tiers := make(map[string][]models.WageTier)
+
+	for _, user := range users {
+		var wage int64
+		if models.IsStaffRole(user.Role) {
+			roleTiers, ok := tiers[user.Role]
+			if !ok {
+				list, err := r.tierRepo.ListByRoleTx(tx, user.Role)
+				if err != nil {
+					return err
+				}
+				tiers[user.Role] = list
+				roleTiers = list
+			}
+
+			var increment int64
+			if base[user.Role] > 0 {
+				increment = incrementFor(roleTiers, user.CompletedWork)
+			}
+			wage = applyWage(base[user.Role], increment, active[user.ID])
+		}
+		if wage == user.CurrentWage {
+			continue
+		}
+
+		if err := tx.
+			Model(&models.User{}).
+			Where("id = ?", user.ID).
+			Update("current_wage", wage).Error; err != nil {
+			return err
+		}
+
+		if err := r.logRepo.CreateTx(tx, &models.WageChangeLog{
+			UserID:        user.ID,
+			Role:          user.Role,
+			OldWage:       user.CurrentWage,
+			NewWage:       wage,
+			CompletedWork: user.CompletedWork,
+			Source:        source,
+			Reason:        reason,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyWage adds the seniority increment to a non-zero base, then lets the
+// override, if any, have the final say.
+func applyWage(base, increment int64, override *models.WageOverride) int64 {
+	wage := base
+	if wage > 0 {
+		wage += increment
+	}
+	if override != nil {
+		return override.Apply(wage)
+	}
+	return wage
+}
+
+// incrementFor expects tiers ordered by threshold, highest first.
+func incrementFor(tiers []models.WageTier, completedWork uint) int64 {
+	for _, tier := range tiers {
+		if completedWork >= tier.MinCompletedWork {
+			return tier.Increment
+		}
+	}
+	return 0
+}
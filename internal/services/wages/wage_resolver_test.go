@@ -0,0 +1,255 @@
+package wages
+
+import (
+	"testing"
+	"time"
+
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type fakeRevisionRepo struct {
+	interfaces.RoleWageRevisionRepository
+	revisions map[string]*models.RoleWageRevision
+}
+
+func (f *fakeRevisionRepo) FindEffectiveTx(tx *gorm.DB, role string, date time.Time) (*models.RoleWageRevision, error) {
+	if r, ok := f.revisions[role]; ok && !r.EffectiveFrom.After(date) {
+		return r, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type fakeTierRepo struct {
+	interfaces.WageTierRepository
+	tiers map[string][]models.WageTier
+}
+
+func (f *fakeTierRepo) ListByRoleTx(tx *gorm.DB, role string) ([]models.WageTier, error) {
+	return f.tiers[role], nil
+}
+
+type fakeOverrideRepo struct {
+	interfaces.WageOverrideRepository
+	overrides []models.WageOverride
+}
+
+func (f *fakeOverrideRepo) FindActiveTx(tx *gorm.DB, userID uint, t time.Time) (*models.WageOverride, error) {
+	var found *models.WageOverride
+	for i := range f.overrides {
+		o := &f.overrides[i]
+		if o.UserID != userID || o.StartsAt.After(t) {
+			continue
+		}
+		if o.ExpiresAt != nil && !o.ExpiresAt.After(t) {
+			continue
+		}
+		if found == nil || o.StartsAt.After(found.StartsAt) {
+			found = o
+		}
+	}
+	if found == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found, nil
+}
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func datePtr(s string) *time.Time {
+	t := date(s)
+	return &t
+}
+
+func newTestResolver(revisions map[string]*models.RoleWageRevision, overrides []models.WageOverride) *WageResolver {
+	tiers := &fakeTierRepo{tiers: map[string][]models.WageTier{
+		models.RoleJuniorBoy: {
+			{Role: models.RoleJuniorBoy, MinCompletedWork: 50, Increment: 100},
+			{Role: models.RoleJuniorBoy, MinCompletedWork: 10, Increment: 50},
+		},
+	}}
+	return NewWageResolver(
+		&fakeRevisionRepo{revisions: revisions},
+		tiers,
+		nil,
+		&fakeOverrideRepo{overrides: overrides},
+	)
+}
+
+func TestWageResolverWageFor(t *testing.T) {
+	at := date("2026-06-15")
+
+	tests := []struct {
+		name      string
+		userID    uint
+		completed uint
+		base      int64
+		overrides []models.WageOverride
+		want      int64
+	}{
+		{
+			name:   "base only below the first tier",
+			userID: 1,
+			base:   500,
+			want:   500,
+		},
+		{
+			name:      "lower tier increment",
+			userID:    1,
+			completed: 20,
+			base:      500,
+			want:      550,
+		},
+		{
+			name:      "highest tier reached wins",
+			userID:    1,
+			completed: 60,
+			base:      500,
+			want:      600,
+		},
+		{
+			name:      "zero base gets no increment",
+			userID:    1,
+			completed: 60,
+			base:      0,
+			want:      0,
+		},
+		{
+			name:      "amount override replaces base and increment",
+			userID:    1,
+			completed: 20,
+			base:      500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-06-01")},
+			},
+			want: 800,
+		},
+		{
+			name:      "percent override applies after the increment",
+			userID:    1,
+			completed: 20,
+			base:      500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverridePercent, Value: 10, StartsAt: date("2026-06-01")},
+			},
+			want: 605,
+		},
+		{
+			name:   "negative percent override floors at zero",
+			userID: 1,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverridePercent, Value: -150, StartsAt: date("2026-06-01")},
+			},
+			want: 0,
+		},
+		{
+			name:   "override starting after t is ignored",
+			userID: 1,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-06-16")},
+			},
+			want: 500,
+		},
+		{
+			name:   "override expired before t is ignored",
+			userID: 1,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-05-01"), ExpiresAt: datePtr("2026-06-15")},
+			},
+			want: 500,
+		},
+		{
+			name:   "replaced override still applies inside its window",
+			userID: 1,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 700, StartsAt: date("2026-05-01"), ExpiresAt: datePtr("2026-07-01")},
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 900, StartsAt: date("2026-07-01")},
+			},
+			want: 700,
+		},
+		{
+			name:   "another user's override is ignored",
+			userID: 1,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 2, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-06-01")},
+			},
+			want: 500,
+		},
+		{
+			name:   "unsaved user is not looked up",
+			userID: 0,
+			base:   500,
+			overrides: []models.WageOverride{
+				{UserID: 0, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-06-01")},
+			},
+			want: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestResolver(nil, tt.overrides)
+			user := &models.User{ID: tt.userID, CompletedWork: tt.completed}
+
+			if got := r.WageFor(nil, user, models.RoleJuniorBoy, tt.base, at); got != tt.want {
+				t.Errorf("WageFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWageResolverBaseWage(t *testing.T) {
+	revisions := map[string]*models.RoleWageRevision{
+		models.RoleJuniorBoy: {Role: models.RoleJuniorBoy, Wage: 500, EffectiveFrom: date("2026-06-01")},
+	}
+
+	tests := []struct {
+		name      string
+		eventDate time.Time
+		overrides []models.WageOverride
+		want      int64
+	}{
+		{
+			name:      "revision in force plus seniority",
+			eventDate: date("2026-06-15"),
+			want:      550,
+		},
+		{
+			name:      "no revision yet falls back to the current wage",
+			eventDate: date("2026-05-15"),
+			want:      450,
+		},
+		{
+			name:      "override in force on the event date",
+			eventDate: date("2026-06-15"),
+			overrides: []models.WageOverride{
+				{UserID: 1, Type: models.WageOverrideAmount, Value: 800, StartsAt: date("2026-06-10")},
+			},
+			want: 800,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestResolver(revisions, tt.overrides)
+			user := &models.User{ID: 1, CompletedWork: 20, CurrentWage: 450}
+
+			if got := r.BaseWage(nil, user, models.RoleJuniorBoy, tt.eventDate); got != tt.want {
+				t.Errorf("BaseWage() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
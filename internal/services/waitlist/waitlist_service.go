@@ -0,0 +1,249 @@
+package waitlist
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/interfaces"
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/wages"
+
+	"gorm.io/gorm"
+)
+
+// ======================= RESPONSE DTO =======================
+
+type WaitlistEntryResponse struct {
+	ID       uint         `json:"id"`
+	Event    models.Event `json:"event"`
+	Role     string       `json:"role"`
+	Position int64        `json:"position"`
+	JoinedAt string       `json:"joined_at"`
+}
+
+// ======================= SERVICE =======================
+
+type WaitlistService struct {
+	waitlistRepo interfaces.WaitlistRepository
+	bookingRepo  interfaces.BookingRepository
+	eventRepo    interfaces.EventRepository
+	userRepo     interfaces.UserRepository
+	wageResolver *wages.WageResolver
+}
+
+func NewWaitlistService(
+	waitlistRepo interfaces.WaitlistRepository,
+	bookingRepo interfaces.BookingRepository,
+	eventRepo interfaces.EventRepository,
+	userRepo interfaces.UserRepository,
+	wageResolver *wages.WageResolver,
+) *WaitlistService {
+	return &WaitlistService{
+		waitlistRepo: waitlistRepo,
+		bookingRepo:  bookingRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		wageResolver: wageResolver,
+	}
+}
+
+// ======================= JOIN =======================
+// RULES:
+// - ONLY UPCOMING EVENTS
+// - ONLY WHEN THE USER'S ROLE IS FULLY BOOKED
+// - CHECKS AND INSERT RUN UNDER THE EVENT ROW LOCK, LIKE BOOKING
+//
+func (s *WaitlistService) Join(userID, eventID uint, role string) (*WaitlistEntryResponse, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.Role != role {
+		return nil, errors.New("role mismatch")
+	}
+
+	var event *models.Event
+	entry := &models.Waitlist{
+		EventID: eventID,
+		UserID:  userID,
+		Role:    role,
+		Status:  models.WaitlistStatusWaiting,
+	}
+
+	err = config.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		event, err = s.eventRepo.FindByIDForUpdate(tx, eventID)
+		if err != nil {
+			return errors.New("event not found")
+		}
+
+		if event.Status != models.EventStatusUpcoming {
+			return errors.New("event is not open for booking")
+		}
+
+		if _, err := s.bookingRepo.FindByEventAndUserTx(tx, eventID, userID); err == nil {
+			return errors.New("already booked")
+		}
+
+		if event.Requirement(role) == nil {
+			return errors.New("event does not need this role")
+		}
+
+		if event.RemainingFor(role) > 0 {
+			return errors.New("slots are still available, book the event directly")
+		}
+
+		if existing, err := s.waitlistRepo.FindByEventAndUserTx(tx, eventID, userID); err == nil {
+			if existing.Status == models.WaitlistStatusWaiting {
+				return errors.New("already on waitlist")
+			}
+			// promoted earlier and cancelled since; start a fresh entry
+			if err := s.waitlistRepo.DeleteTx(tx, existing.ID); err != nil {
+				return err
+			}
+		}
+
+		return s.waitlistRepo.CreateTx(tx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ahead, err := s.waitlistRepo.CountAhead(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WaitlistEntryResponse{
+		ID:       entry.ID,
+		Event:    *event,
+		Role:     entry.Role,
+		Position: ahead + 1,
+		JoinedAt: entry.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ======================= LEAVE =======================
+
+func (s *WaitlistService) Leave(userID, eventID uint) error {
+	entry, err := s.waitlistRepo.FindByEventAndUser(eventID, userID)
+	if err != nil || entry.Status != models.WaitlistStatusWaiting {
+		return errors.New("you are not on the waitlist for this event")
+	}
+	return s.waitlistRepo.Delete(entry.ID)
+}
+
+// ======================= LIST MY WAITLIST =======================
+
+func (s *WaitlistService) ListMine(userID uint) ([]WaitlistEntryResponse, error) {
+	entries, err := s.waitlistRepo.ListByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]WaitlistEntryResponse, 0, len(entries))
+	for i := range entries {
+		ahead, err := s.waitlistRepo.CountAhead(&entries[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, WaitlistEntryResponse{
+			ID:       entries[i].ID,
+			Event:    entries[i].Event,
+			Role:     entries[i].Role,
+			Position: ahead + 1,
+			JoinedAt: entries[i].CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
+
+// ======================= PROMOTE =======================
+// Fills free slots of a locked upcoming event from its waitlist in join
+// order. The caller owns the transaction and must save the event afterwards.
+//
+func (s *WaitlistService) PromoteTx(tx *gorm.DB, event *models.Event) error {
+	if event.Status != models.EventStatusUpcoming {
+		return nil
+	}
+
+	for _, req := range event.RoleRequirements {
+		role := req.Role
+		if event.RemainingFor(role) == 0 {
+			continue
+		}
+
+		entries, err := s.waitlistRepo.ListWaitingForUpdate(tx, event.ID, role)
+		if err != nil {
+			return err
+		}
+
+		for i := range entries {
+			if event.RemainingFor(role) == 0 {
+				break
+			}
+
+			entry := &entries[i]
+
+			var user models.User
+			if err := tx.
+				Where("id = ? AND deleted_at IS NULL", entry.UserID).
+				First(&user).Error; err != nil {
+				continue
+			}
+			if user.Status != models.StatusActive || user.Role != entry.Role {
+				continue
+			}
+
+			busy, err := s.hasBookingOnDate(tx, user.ID, event.Date)
+			if err != nil {
+				return err
+			}
+			if busy {
+				continue
+			}
+
+			booking := &models.Booking{
+				EventID:    event.ID,
+				UserID:     user.ID,
+				Role:       entry.Role,
+				Status:     models.BookingStatusBooked,
+				BaseAmount: s.wageResolver.BaseWage(tx, &user, entry.Role, event.Date),
+			}
+			if err := tx.Create(booking).Error; err != nil {
+				return err
+			}
+
+			event.TakeSlot(role)
+
+			now := time.Now()
+			entry.Status = models.WaitlistStatusPromoted
+			entry.BookingID = &booking.ID
+			entry.PromotedAt = &now
+			if err := s.waitlistRepo.UpdateTx(tx, entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ======================= INTERNAL =======================
+// Mirrors the availability rule: one booking per user per day.
+func (s *WaitlistService) hasBookingOnDate(tx *gorm.DB, userID uint, date time.Time) (bool, error) {
+	var count int64
+	err := tx.
+		Table("bookings").
+		Joins("JOIN events ON events.id = bookings.event_id").
+		Where(`
+			bookings.user_id = ?
+			AND bookings.deleted_at IS NULL
+			AND events.deleted_at IS NULL
+			AND DATE(events.date) = DATE(?)
+		`, userID, date).
+		Count(&count).Error
+	return count > 0, err
+}
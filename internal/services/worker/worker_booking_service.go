@@ -2,11 +2,16 @@ package worker
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"event-management-backend/internal/config"
 	"event-management-backend/internal/domain/interfaces"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/services/adjustments"
+	"event-management-backend/internal/services/cancellation"
+	"event-management-backend/internal/services/waitlist"
+	"event-management-backend/internal/services/wages"
 
 	"gorm.io/gorm"
 )
@@ -30,25 +35,48 @@ type BookingDTO struct {
 	FineAmount  int64  `json:"fine_amount"`
 	TotalAmount int64  `json:"total_amount"`
 	CreatedAt   string `json:"created_at"`
+
+	AdvanceDeduction int64 `json:"advance_deduction"`
+
+	PaymentStatus string     `json:"payment_status"`
+	PaidAt        *time.Time `json:"paid_at"`
+
+	// amounts waiting for admin approval, nil when none
+	Pending *adjustments.Pending `json:"pending"`
 }
 
 // ======================= SERVICE =======================
 
 type WorkerBookingService struct {
-	bookingRepo interfaces.BookingRepository
-	eventRepo   interfaces.EventRepository
-	userRepo    interfaces.UserRepository
+	bookingRepo      interfaces.BookingRepository
+	eventRepo        interfaces.EventRepository
+	userRepo         interfaces.UserRepository
+	settingRepo      interfaces.SettingRepository
+	canceller        *cancellation.Canceller
+	waitlistService  *waitlist.WaitlistService
+	wageResolver     *wages.WageResolver
+	adjustments      *adjustments.Applier
 }
 
 func NewWorkerBookingService(
 	bookingRepo interfaces.BookingRepository,
 	eventRepo interfaces.EventRepository,
 	userRepo interfaces.UserRepository,
+	cancellationRepo interfaces.BookingCancellationRepository,
+	settingRepo interfaces.SettingRepository,
+	waitlistService *waitlist.WaitlistService,
+	wageResolver *wages.WageResolver,
+	adjustments *adjustments.Applier,
 ) *WorkerBookingService {
 	return &WorkerBookingService{
-		bookingRepo: bookingRepo,
-		eventRepo:   eventRepo,
-		userRepo:    userRepo,
+		bookingRepo:      bookingRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		settingRepo:      settingRepo,
+		canceller:        cancellation.NewCanceller(bookingRepo, eventRepo, cancellationRepo, settingRepo, waitlistService),
+		waitlistService:  waitlistService,
+		wageResolver:     wageResolver,
+		adjustments:      adjustments,
 	}
 }
 
@@ -79,30 +107,15 @@ func (s *WorkerBookingService) BookEvent(userID, eventID uint, role string) erro
 			return errors.New("already booked")
 		}
 
-		switch user.Role {
-		case models.RoleSubCaptain:
-			if event.RemainingSubCaptains == 0 {
-				return errors.New("no sub-captain slots available")
-			}
-			event.RemainingSubCaptains--
-
-		case models.RoleMainBoy:
-			if event.RemainingMainBoys == 0 {
-				return errors.New("no main boy slots available")
-			}
-			event.RemainingMainBoys--
-
-		case models.RoleJuniorBoy:
-			if event.RemainingJuniors == 0 {
-				return errors.New("no junior slots available")
-			}
-			event.RemainingJuniors--
-
-		default:
+		if !models.IsStaffRole(user.Role) || user.Role == models.RoleCaptain {
 			return errors.New("invalid role")
 		}
 
-		if err := tx.Save(event).Error; err != nil {
+		if !event.TakeSlot(user.Role) {
+			return fmt.Errorf("no %s slots available", models.RoleLabel(user.Role))
+		}
+
+		if err := s.eventRepo.SaveTx(tx, event); err != nil {
 			return err
 		}
 
@@ -111,19 +124,30 @@ func (s *WorkerBookingService) BookEvent(userID, eventID uint, role string) erro
 			UserID:     userID,
 			Role:       user.Role,
 			Status:     models.BookingStatusBooked,
-			BaseAmount: user.CurrentWage,
+			BaseAmount: s.wageResolver.BaseWage(tx, user, user.Role, event.Date),
 		}
 
 		return tx.Create(booking).Error
 	})
 }
 
+// ======================= CANCEL BOOKING =======================
+// RULES:
+// - ONLY UPCOMING EVENTS
+// - REFUSED ONCE THE CUTOFF BEFORE REPORTING TIME HAS PASSED
+// - LATE CANCELLATIONS ARE FLAGGED FOR ADMINS
+//
+func (s *WorkerBookingService) CancelBooking(userID, bookingID uint) error {
+	return s.canceller.CancelOwn(userID, bookingID)
+}
+
 // ======================= INTERNAL MAPPER =======================
 
 func mapWorkerBookings(bookings []models.Booking) []WorkerBookingResponse {
 	res := make([]WorkerBookingResponse, 0, len(bookings))
 
 	for _, b := range bookings {
+		paymentStatus, paidAt := b.PaymentStatus()
 		res = append(res, WorkerBookingResponse{
 			Event: b.Event,
 			MyBooking: BookingDTO{
@@ -138,6 +162,11 @@ func mapWorkerBookings(bookings []models.Booking) []WorkerBookingResponse {
 				FineAmount:  b.FineAmount,
 				TotalAmount: b.TotalAmount,
 				CreatedAt:   b.CreatedAt.Format(time.RFC3339),
+
+				AdvanceDeduction: b.AdvanceDeduction,
+
+				PaymentStatus: paymentStatus,
+				PaidAt:        paidAt,
 			},
 		})
 	}
@@ -145,6 +174,28 @@ func mapWorkerBookings(bookings []models.Booking) []WorkerBookingResponse {
 	return res
 }
 
+// withPending shows amounts waiting for admin approval next to the
+// approved ones.
+func (s *WorkerBookingService) withPending(res []WorkerBookingResponse, err error) ([]WorkerBookingResponse, error) {
+	if err != nil || len(res) == 0 {
+		return res, err
+	}
+
+	ids := make([]uint, 0, len(res))
+	for _, r := range res {
+		ids = append(ids, r.MyBooking.ID)
+	}
+
+	pending, err := s.adjustments.PendingFor(ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		res[i].MyBooking.Pending = pending[res[i].MyBooking.ID]
+	}
+	return res, nil
+}
+
 // ======================= LIST MY BOOKINGS =======================
 
 func (s *WorkerBookingService) ListMyBookings(userID uint) ([]WorkerBookingResponse, error) {
@@ -152,6 +203,7 @@ func (s *WorkerBookingService) ListMyBookings(userID uint) ([]WorkerBookingRespo
 
 	err := config.DB.
 		Preload("Event").
+		Preload("Payout").
 		Joins("JOIN events ON events.id = bookings.event_id").
 		Where(`
 			bookings.user_id = ?
@@ -165,7 +217,7 @@ func (s *WorkerBookingService) ListMyBookings(userID uint) ([]WorkerBookingRespo
 		Order("events.date ASC").
 		Find(&bookings).Error
 
-	return mapWorkerBookings(bookings), err
+	return s.withPending(mapWorkerBookings(bookings), err)
 }
 
 // ======================= LIST COMPLETED BOOKINGS =======================
@@ -175,6 +227,7 @@ func (s *WorkerBookingService) ListCompletedBookings(userID uint) ([]WorkerBooki
 
 	err := config.DB.
 		Preload("Event").
+		Preload("Payout").
 		Joins("JOIN events ON events.id = bookings.event_id").
 		Where(`
 			bookings.user_id = ?
@@ -187,7 +240,7 @@ func (s *WorkerBookingService) ListCompletedBookings(userID uint) ([]WorkerBooki
 		Order("events.date DESC").
 		Find(&bookings).Error
 
-	return mapWorkerBookings(bookings), err
+	return s.withPending(mapWorkerBookings(bookings), err)
 }
 
 // ======================= GET BOOKING DETAILS =======================
@@ -202,6 +255,7 @@ func (s *WorkerBookingService) GetBookingDetails(
 
 	err := config.DB.
 		Preload("Event").
+		Preload("Payout").
 		Where(
 			"id = ? AND user_id = ? AND deleted_at IS NULL",
 			bookingID,
@@ -213,6 +267,8 @@ func (s *WorkerBookingService) GetBookingDetails(
 		return nil, errors.New("booking not found or unauthorized")
 	}
 
+	paymentStatus, paidAt := booking.PaymentStatus()
+
 	res := WorkerBookingResponse{
 		Event: booking.Event,
 		MyBooking: BookingDTO{
@@ -227,8 +283,19 @@ func (s *WorkerBookingService) GetBookingDetails(
 			FineAmount:  booking.FineAmount,
 			TotalAmount: booking.TotalAmount,
 			CreatedAt:   booking.CreatedAt.Format(time.RFC3339),
+
+			AdvanceDeduction: booking.AdvanceDeduction,
+
+			PaymentStatus: paymentStatus,
+			PaidAt:        paidAt,
 		},
 	}
 
+	pending, err := s.adjustments.PendingFor([]uint{booking.ID})
+	if err != nil {
+		return nil, err
+	}
+	res.MyBooking.Pending = pending[booking.ID]
+
 	return &res, nil
 }
\ No newline at end of file
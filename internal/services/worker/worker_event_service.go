@@ -20,19 +20,11 @@ func NewWorkerEventService(repo interfaces.EventRepository) *WorkerEventService
 func (s *WorkerEventService) ListAvailableEvents(userID uint, role string) ([]models.Event, error) {
 	today := time.Now().Truncate(24 * time.Hour)
 
-	switch role {
-	case models.RoleSubCaptain:
-		return s.repo.ListAvailableForSubCaptain(userID, today)
-
-	case models.RoleMainBoy:
-		return s.repo.ListAvailableForMainBoy(userID, today)
-
-	case models.RoleJuniorBoy:
-		return s.repo.ListAvailableForJunior(userID, today)
-
-	default:
+	if !models.IsStaffRole(role) || role == models.RoleCaptain {
 		return nil, errors.New("invalid worker role")
 	}
+
+	return s.repo.ListAvailableForRole(userID, role, today)
 }
 
 func (s *WorkerEventService) GetEvent(id uint) (*models.Event, error) {
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCheckInToken = errors.New("invalid check-in token")
+	ErrExpiredCheckInToken = errors.New("check-in token has expired")
+)
+
+// SignCheckInToken returns a short-lived token "<booking>.<expiry>.<sig>"
+// that a captain can scan to check the booking in or out.
+func SignCheckInToken(bookingID uint, ttl time.Duration) (string, time.Time, error) {
+	secret := checkInSecret()
+	if secret == "" {
+		return "", time.Time{}, errors.New("check-in signing key not configured")
+	}
+
+	expires := time.Now().Add(ttl)
+	payload := fmt.Sprintf("%d.%d", bookingID, expires.Unix())
+	return payload + "." + signCheckIn(secret, payload), expires, nil
+}
+
+// VerifyCheckInToken checks the signature and expiry and returns the booking id.
+func VerifyCheckInToken(token string) (uint, error) {
+	secret := checkInSecret()
+	if secret == "" {
+		return 0, errors.New("check-in signing key not configured")
+	}
+
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidCheckInToken
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signCheckIn(secret, payload))) {
+		return 0, ErrInvalidCheckInToken
+	}
+
+	bookingID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || bookingID == 0 {
+		return 0, ErrInvalidCheckInToken
+	}
+
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCheckInToken
+	}
+	if time.Now().Unix() > expiry {
+		return 0, ErrExpiredCheckInToken
+	}
+
+	return uint(bookingID), nil
+}
+
+func signCheckIn(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("checkin:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func checkInSecret() string {
+	if s := os.Getenv("CHECKIN_SECRET"); s != "" {
+		return s
+	}
+	return os.Getenv("JWT_SECRET")
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const earthRadiusMeters = 6371000.0
+
+// DistanceMeters returns the great-circle (haversine) distance between two
+// points given in decimal degrees.
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*
+			math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// ValidCoordinates reports whether lat/lng are within the valid ranges.
+func ValidCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+var (
+	atCoordsPattern   = regexp.MustCompile(`@(-?\d+(?:\.\d+)?),(-?\d+(?:\.\d+)?)`)
+	dataCoordsPattern = regexp.MustCompile(`!3d(-?\d+(?:\.\d+)?)!4d(-?\d+(?:\.\d+)?)`)
+	pairPattern       = regexp.MustCompile(`^\s*(-?\d+(?:\.\d+)?)\s*,\s*(-?\d+(?:\.\d+)?)\s*$`)
+)
+
+// ParseCoordinates extracts a latitude/longitude pair from a maps link such
+// as ".../@12.97,77.59,17z", "...?q=12.97,77.59" or a bare "12.97,77.59".
+// Short links that need a redirect cannot be resolved offline.
+func ParseCoordinates(link string) (float64, float64, bool) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return 0, 0, false
+	}
+
+	// place pins are more precise than the map viewport
+	if m := dataCoordsPattern.FindStringSubmatch(link); m != nil {
+		return parsePair(m[1], m[2])
+	}
+
+	if u, err := url.Parse(link); err == nil {
+		q := u.Query()
+		for _, key := range []string{"q", "query", "ll", "destination", "daddr"} {
+			if m := pairPattern.FindStringSubmatch(q.Get(key)); m != nil {
+				return parsePair(m[1], m[2])
+			}
+		}
+	}
+
+	if m := atCoordsPattern.FindStringSubmatch(link); m != nil {
+		return parsePair(m[1], m[2])
+	}
+
+	if m := pairPattern.FindStringSubmatch(link); m != nil {
+		return parsePair(m[1], m[2])
+	}
+
+	return 0, 0, false
+}
+
+func parsePair(a, b string) (float64, float64, bool) {
+	lat, err1 := strconv.ParseFloat(a, 64)
+	lng, err2 := strconv.ParseFloat(b, 64)
+	if err1 != nil || err2 != nil || !ValidCoordinates(lat, lng) {
+		return 0, 0, false
+	}
+	return lat, lng, true
+}
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"errors"
 	"strconv"
+	"time"
 )
 
 func ParseUintParam(param string) uint {
@@ -11,3 +13,27 @@ func ParseUintParam(param string) uint {
 	}
 	return uint(id)
 }
+
+// ParseDateRange parses optional YYYY-MM-DD bounds; empty values give nil.
+func ParseDateRange(from, to string) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+
+	if from != "" {
+		t, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return nil, nil, errors.New("from must be YYYY-MM-DD")
+		}
+		start = &t
+	}
+	if to != "" {
+		t, err := time.Parse("2006-01-02", to)
+		if err != nil {
+			return nil, nil, errors.New("to must be YYYY-MM-DD")
+		}
+		end = &t
+	}
+	if start != nil && end != nil && end.Before(*start) {
+		return nil, nil, errors.New("to cannot be before from")
+	}
+	return start, end, nil
+}
@@ -1,6 +1,23 @@
 package utils
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	MinPasswordLength = 8
+	// bcrypt ignores everything past 72 bytes
+	MaxPasswordLength = 72
+)
+
+var (
+	letterRegex = regexp.MustCompile(`[A-Za-z]`)
+	digitRegex  = regexp.MustCompile(`[0-9]`)
+)
 
 func HashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -10,4 +27,22 @@ func HashPassword(password string) (string, error) {
 func CheckPasswordHash(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
-}
\ No newline at end of file
+}
+
+// CheckPasswordStrength is the rule for every password a user gets, whether
+// an admin creates the account or the user changes or resets it.
+func CheckPasswordStrength(password, phone string) error {
+	if len(password) < MinPasswordLength {
+		return errors.New("password must be at least 8 characters")
+	}
+	if len(password) > MaxPasswordLength {
+		return errors.New("password must be at most 72 characters")
+	}
+	if !letterRegex.MatchString(password) || !digitRegex.MatchString(password) {
+		return errors.New("password must contain a letter and a number")
+	}
+	if phone != "" && strings.Contains(password, phone) {
+		return errors.New("password must not contain the phone number")
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package utils
+
+import qrcode "github.com/skip2/go-qrcode"
+
+// QRCodePNG renders content as a size x size PNG.
+func QRCodePNG(content string, size int) ([]byte, error) {
+	return qrcode.Encode(content, qrcode.Medium, size)
+}
@@ -0,0 +1,40 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+
+	"event-management-backend/internal/domain/models"
+)
+
+type AdjustmentRuleRequest struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Amount      int64  `json:"amount"`
+	Description string `json:"description"`
+	Active      *bool  `json:"active"`
+}
+
+func (r *AdjustmentRuleRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return errors.New("rule name is required")
+	}
+	if len(r.Name) > 150 {
+		return errors.New("rule name is too long")
+	}
+
+	switch r.Kind {
+	case models.AdjustmentKindFine, models.AdjustmentKindBonus:
+	default:
+		return errors.New("kind must be fine or bonus")
+	}
+
+	if r.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if len(r.Description) > 255 {
+		return errors.New("description is too long")
+	}
+	return nil
+}
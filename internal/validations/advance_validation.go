@@ -0,0 +1,77 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type IssueAdvanceRequest struct {
+	Amount            int64      `json:"amount"`
+	PerEventCap       int64      `json:"per_event_cap"`
+	InstallmentAmount int64      `json:"installment_amount"`
+	IssuedAt          *time.Time `json:"issued_at"`
+	Note              string     `json:"note"`
+}
+
+func (r *IssueAdvanceRequest) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if r.IssuedAt != nil && r.IssuedAt.After(time.Now()) {
+		return errors.New("issue date cannot be in the future")
+	}
+	return validateAdvanceSchedule(r.PerEventCap, r.InstallmentAmount, &r.Note)
+}
+
+type UpdateAdvanceRequest struct {
+	PerEventCap       int64  `json:"per_event_cap"`
+	InstallmentAmount int64  `json:"installment_amount"`
+	Note              string `json:"note"`
+}
+
+func (r *UpdateAdvanceRequest) Validate() error {
+	return validateAdvanceSchedule(r.PerEventCap, r.InstallmentAmount, &r.Note)
+}
+
+type AdvanceRepaymentRequest struct {
+	Amount int64  `json:"amount"`
+	Note   string `json:"note"`
+}
+
+func (r *AdvanceRepaymentRequest) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	r.Note = strings.TrimSpace(r.Note)
+	if len(r.Note) > 255 {
+		return errors.New("note is too long")
+	}
+	return nil
+}
+
+type WriteOffAdvanceRequest struct {
+	Note string `json:"note"`
+}
+
+func (r *WriteOffAdvanceRequest) Validate() error {
+	r.Note = strings.TrimSpace(r.Note)
+	if r.Note == "" {
+		return errors.New("a note explaining the write-off is required")
+	}
+	if len(r.Note) > 255 {
+		return errors.New("note is too long")
+	}
+	return nil
+}
+
+func validateAdvanceSchedule(perEventCap, installment int64, note *string) error {
+	if perEventCap < 0 || installment < 0 {
+		return errors.New("deduction limits cannot be negative")
+	}
+	*note = strings.TrimSpace(*note)
+	if len(*note) > 255 {
+		return errors.New("note is too long")
+	}
+	return nil
+}
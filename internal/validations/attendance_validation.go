@@ -2,16 +2,19 @@ package validations
 
 import (
 	"errors"
+	"strings"
 
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
 )
 
 type UpdateAttendanceRequest struct {
 	BookingID   uint   `json:"booking_id" binding:"required"`
 	Status      string `json:"status" binding:"required"`
-	TAAmount    int64  `json:"ta_amount"`
-	BonusAmount int64  `json:"bonus_amount"`
-	FineAmount  int64  `json:"fine_amount"`
+	TAAmount    *int64 `json:"ta_amount"` // omit to use the proposed TA
+	BonusAmount int64  `json:"bonus_amount"` // manual, admins only
+	FineAmount  int64  `json:"fine_amount"`  // manual, admins only
+	RuleIDs     []uint `json:"rule_ids"`     // fines and bonuses from the rule catalogue
 }
 
 func (r *UpdateAttendanceRequest) Validate() error {
@@ -31,7 +34,7 @@ func (r *UpdateAttendanceRequest) Validate() error {
 	}
 
 	// ---------------- AMOUNTS ----------------
-	if r.TAAmount < 0 {
+	if r.TAAmount != nil && *r.TAAmount < 0 {
 		return errors.New("ta amount cannot be negative")
 	}
 	if r.BonusAmount < 0 {
@@ -43,10 +46,39 @@ func (r *UpdateAttendanceRequest) Validate() error {
 
 	// ---------------- ABSENT RULE ----------------
 	if r.Status == models.BookingStatusAbsent {
-		if r.TAAmount != 0 || r.BonusAmount != 0 || r.FineAmount != 0 {
+		if (r.TAAmount != nil && *r.TAAmount != 0) || r.BonusAmount != 0 || r.FineAmount != 0 {
 			return errors.New("amounts must be zero when status is absent")
 		}
+		if len(r.RuleIDs) != 0 {
+			return errors.New("adjustment rules cannot be applied when status is absent")
+		}
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+type ScanCheckInRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+func (r *ScanCheckInRequest) Validate() error {
+	if strings.TrimSpace(r.Token) == "" {
+		return errors.New("token is required")
+	}
+	return nil
+}
+
+type SelfCheckInRequest struct {
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+func (r *SelfCheckInRequest) Validate() error {
+	if r.Latitude == nil || r.Longitude == nil {
+		return errors.New("latitude and longitude are required")
+	}
+	if !utils.ValidCoordinates(*r.Latitude, *r.Longitude) {
+		return errors.New("invalid latitude or longitude")
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package validations
 import (
 	"errors"
 	"regexp"
+
+	"event-management-backend/internal/utils"
 )
 
 var PhoneRegex = regexp.MustCompile(`^[0-9]{10}$`)
@@ -10,6 +12,8 @@ var PhoneRegex = regexp.MustCompile(`^[0-9]{10}$`)
 type LoginRequest struct {
 	Phone    string `json:"phone" binding:"required"`
 	Password string `json:"password" binding:"required"`
+	// DeviceName labels the session in the device list, e.g. "Office laptop"
+	DeviceName string `json:"device_name"`
 }
 
 func (r *LoginRequest) Validate() error {
@@ -19,5 +23,77 @@ func (r *LoginRequest) Validate() error {
 	if len(r.Password) < 4 {
 		return errors.New("password must be at least 4 characters")
 	}
+	if len(r.DeviceName) > 100 {
+		return errors.New("device name is too long")
+	}
+	return nil
+}
+
+var otpRegex = regexp.MustCompile(`^[0-9]{6}$`)
+
+type OTPRequest struct {
+	Phone string `json:"phone" binding:"required"`
+}
+
+func (r *OTPRequest) Validate() error {
+	if !PhoneRegex.MatchString(r.Phone) {
+		return errors.New("invalid phone number")
+	}
+	return nil
+}
+
+type OTPVerifyRequest struct {
+	Phone      string `json:"phone" binding:"required"`
+	OTP        string `json:"otp" binding:"required"`
+	DeviceName string `json:"device_name"`
+}
+
+func (r *OTPVerifyRequest) Validate() error {
+	if !PhoneRegex.MatchString(r.Phone) {
+		return errors.New("invalid phone number")
+	}
+	if !otpRegex.MatchString(r.OTP) {
+		return errors.New("otp must be 6 digits")
+	}
+	if len(r.DeviceName) > 100 {
+		return errors.New("device name is too long")
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// Validate leaves the phone number rule to the service, which has the user.
+func (r *ChangePasswordRequest) Validate() error {
+	if r.CurrentPassword == r.NewPassword {
+		return errors.New("new password cannot be same as old password")
+	}
+	return utils.CheckPasswordStrength(r.NewPassword, "")
+}
+
+type ForgotPasswordRequest struct {
+	Phone string `json:"phone" binding:"required"`
+}
+
+func (r *ForgotPasswordRequest) Validate() error {
+	if !PhoneRegex.MatchString(r.Phone) {
+		return errors.New("invalid phone number")
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// Validate leaves the phone number rule to the service, which has the user.
+func (r *ResetPasswordRequest) Validate() error {
+	if len(r.Token) < 20 || len(r.Token) > 200 {
+		return errors.New("invalid reset token")
+	}
+	return utils.CheckPasswordStrength(r.NewPassword, "")
+}
@@ -0,0 +1,61 @@
+package validations
+
+import (
+	"errors"
+	"time"
+
+	"event-management-backend/internal/domain/models"
+)
+
+/*
+|--------------------------------------------------------------------------
+| CREATE EVENT SERIES REQUEST
+|--------------------------------------------------------------------------
+*/
+
+type CreateEventSeriesRequest struct {
+	Frequency string     `json:"frequency"`
+	Interval  uint       `json:"interval"`
+	Weekdays  []string   `json:"weekdays"`
+	StartDate time.Time  `json:"start_date"`
+	UntilDate *time.Time `json:"until_date"`
+	Count     uint       `json:"count"`
+
+	// event fields shared by every occurrence; Event.Date is ignored
+	Event CreateEventRequest `json:"event"`
+}
+
+func (r *CreateEventSeriesRequest) Validate() error {
+	switch r.Frequency {
+	case models.RecurrenceDaily, models.RecurrenceWeekly, models.RecurrenceMonthly:
+	default:
+		return errors.New("invalid recurrence frequency")
+	}
+
+	if r.StartDate.IsZero() {
+		return errors.New("start date is required")
+	}
+
+	if r.UntilDate == nil && r.Count == 0 {
+		return errors.New("until date or count is required")
+	}
+	if r.UntilDate != nil && r.UntilDate.Before(r.StartDate) {
+		return errors.New("until date cannot be before start date")
+	}
+	if r.Count > models.MaxSeriesOccurrences {
+		return errors.New("count is too large")
+	}
+
+	if len(r.Weekdays) > 0 && r.Frequency != models.RecurrenceWeekly {
+		return errors.New("weekdays are only allowed for weekly series")
+	}
+	for _, d := range r.Weekdays {
+		if !models.ValidWeekday(d) {
+			return errors.New("invalid weekday " + d)
+		}
+	}
+
+	// the first occurrence carries the same date rules as a single event
+	r.Event.Date = r.StartDate
+	return r.Event.Validate()
+}
@@ -0,0 +1,126 @@
+package validations
+
+import (
+	"errors"
+	"time"
+)
+
+/*
+|--------------------------------------------------------------------------
+| EVENT TEMPLATE REQUEST (CREATE / UPDATE)
+|--------------------------------------------------------------------------
+*/
+
+type EventTemplateRequest struct {
+	Name          string `json:"name"`
+	EventName     string `json:"event_name"`
+	TimeSlot      string `json:"time_slot"`
+	ReportingTime string `json:"reporting_time"`
+	WorkType      string `json:"work_type"`
+	LocationLink  string `json:"location_link"`
+
+	RoleRequirements []RoleRequirementInput `json:"role_requirements"`
+
+	LongWork          bool   `json:"long_work"`
+	TransportProvided bool   `json:"transport_provided"`
+	TransportType     string `json:"transport_type"`
+	ExtraWageAmount   int64  `json:"extra_wage_amount"`
+}
+
+func (r *EventTemplateRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("template name is required")
+	}
+
+	if r.TimeSlot != "" && !isValidTimeSlot(r.TimeSlot) {
+		return errors.New("invalid time slot")
+	}
+
+	if err := validateRoleRequirements(r.RoleRequirements); err != nil {
+		return err
+	}
+
+	if r.ExtraWageAmount < 0 {
+		return errors.New("extra wage amount cannot be negative")
+	}
+	if !r.LongWork && r.ExtraWageAmount != 0 {
+		return errors.New("extra wage must be zero when long work is false")
+	}
+
+	if r.TransportProvided {
+		if !isValidTransportType(r.TransportType) {
+			return errors.New("invalid transport type")
+		}
+	}
+
+	return nil
+}
+
+/*
+|--------------------------------------------------------------------------
+| EVENT FROM TEMPLATE REQUEST
+|--------------------------------------------------------------------------
+*/
+
+// EventFromTemplateRequest needs only a date; any other field set here
+// overrides the template value.
+type EventFromTemplateRequest struct {
+	Date          time.Time `json:"date"`
+	Name          string    `json:"name"`
+	TimeSlot      string    `json:"time_slot"`
+	ReportingTime string    `json:"reporting_time"`
+	WorkType      string    `json:"work_type"`
+	LocationLink  string    `json:"location_link"`
+
+	RoleRequirements []RoleRequirementInput `json:"role_requirements"`
+
+	ExtraWageAmount *int64 `json:"extra_wage_amount"`
+}
+
+func (r *EventFromTemplateRequest) Validate() error {
+	if r.Date.IsZero() {
+		return errors.New("event date is required")
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	if r.Date.Before(today) {
+		return errors.New("event date cannot be in the past")
+	}
+
+	if r.TimeSlot != "" && !isValidTimeSlot(r.TimeSlot) {
+		return errors.New("invalid time slot")
+	}
+
+	if err := validateRoleRequirements(r.RoleRequirements); err != nil {
+		return err
+	}
+
+	if r.ExtraWageAmount != nil && *r.ExtraWageAmount < 0 {
+		return errors.New("extra wage amount cannot be negative")
+	}
+
+	return nil
+}
+
+/*
+|--------------------------------------------------------------------------
+| CLONE EVENT REQUEST
+|--------------------------------------------------------------------------
+*/
+
+// CloneEventRequest is optional; without a date the clone keeps the
+// original date, which must not be in the past.
+type CloneEventRequest struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+func (r *CloneEventRequest) Validate() error {
+	if !r.Date.IsZero() {
+		today := time.Now().Truncate(24 * time.Hour)
+		if r.Date.Before(today) {
+			return errors.New("event date cannot be in the past")
+		}
+	}
+	return nil
+}
@@ -2,11 +2,24 @@ package validations
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
 )
 
+/*
+|--------------------------------------------------------------------------
+| ROLE REQUIREMENT INPUT
+|--------------------------------------------------------------------------
+*/
+
+type RoleRequirementInput struct {
+	Role     string `json:"role"`
+	Required uint   `json:"required"`
+}
+
 /*
 |--------------------------------------------------------------------------
 | CREATE EVENT REQUEST
@@ -20,11 +33,11 @@ type CreateEventRequest struct {
 	ReportingTime       string    `json:"reporting_time"`
 	WorkType            string    `json:"work_type"`
 	LocationLink        string    `json:"location_link"`
+	Latitude            *float64  `json:"latitude"`
+	Longitude           *float64  `json:"longitude"`
+	RadiusMeters        uint      `json:"radius_meters"`
 
-	RequiredCaptains    uint `json:"required_captains"`
-	RequiredSubCaptains uint `json:"required_sub_captains"`
-	RequiredMainBoys    uint `json:"required_main_boys"`
-	RequiredJuniors     uint `json:"required_juniors"`
+	RoleRequirements []RoleRequirementInput `json:"role_requirements"`
 
 	LongWork          bool   `json:"long_work"`
 	TransportProvided bool   `json:"transport_provided"`
@@ -61,11 +74,22 @@ func (r *CreateEventRequest) Validate() error {
 		return errors.New("invalid time slot")
 	}
 
+	// venue coordinates
+	if err := validateCoordinates(r.Latitude, r.Longitude); err != nil {
+		return err
+	}
+
+	// role requirements
+	if err := validateRoleRequirements(r.RoleRequirements); err != nil {
+		return err
+	}
+
 	// at least one worker required
-	if r.RequiredCaptains == 0 &&
-		r.RequiredSubCaptains == 0 &&
-		r.RequiredMainBoys == 0 &&
-		r.RequiredJuniors == 0 {
+	var total uint
+	for _, req := range r.RoleRequirements {
+		total += req.Required
+	}
+	if total == 0 {
 		return errors.New("at least one worker is required")
 	}
 
@@ -100,11 +124,11 @@ type UpdateEventRequest struct {
 	ReportingTime       string    `json:"reporting_time"`
 	WorkType            string    `json:"work_type"`
 	LocationLink        string    `json:"location_link"`
+	Latitude            *float64  `json:"latitude"`
+	Longitude           *float64  `json:"longitude"`
+	RadiusMeters        uint      `json:"radius_meters"`
 
-	RequiredCaptains    uint `json:"required_captains"`
-	RequiredSubCaptains uint `json:"required_sub_captains"`
-	RequiredMainBoys    uint `json:"required_main_boys"`
-	RequiredJuniors     uint `json:"required_juniors"`
+	RoleRequirements []RoleRequirementInput `json:"role_requirements"`
 
 	LongWork          bool   `json:"long_work"`
 	TransportProvided bool   `json:"transport_provided"`
@@ -126,6 +150,16 @@ func (r *UpdateEventRequest) Validate() error {
 		return errors.New("invalid time slot")
 	}
 
+	// venue coordinates
+	if err := validateCoordinates(r.Latitude, r.Longitude); err != nil {
+		return err
+	}
+
+	// role requirements (only listed roles are changed)
+	if err := validateRoleRequirements(r.RoleRequirements); err != nil {
+		return err
+	}
+
 	// extra wage rules
 	if r.ExtraWageAmount < 0 {
 		return errors.New("extra wage amount cannot be negative")
@@ -150,6 +184,33 @@ func (r *UpdateEventRequest) Validate() error {
 |--------------------------------------------------------------------------
 */
 
+func validateRoleRequirements(reqs []RoleRequirementInput) error {
+	seen := make(map[string]bool, len(reqs))
+	for _, req := range reqs {
+		if !models.IsStaffRole(req.Role) {
+			return fmt.Errorf("invalid role %q in role requirements", req.Role)
+		}
+		if seen[req.Role] {
+			return fmt.Errorf("duplicate role %q in role requirements", req.Role)
+		}
+		seen[req.Role] = true
+	}
+	return nil
+}
+
+func validateCoordinates(lat, lng *float64) error {
+	if lat == nil && lng == nil {
+		return nil
+	}
+	if lat == nil || lng == nil {
+		return errors.New("latitude and longitude must be given together")
+	}
+	if !utils.ValidCoordinates(*lat, *lng) {
+		return errors.New("invalid latitude or longitude")
+	}
+	return nil
+}
+
 func isValidTimeSlot(slot string) bool {
 	switch slot {
 	case models.TimeSlotMorning,
@@ -0,0 +1,41 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type CreatePayrollPeriodRequest struct {
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+func (r *CreatePayrollPeriodRequest) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("period name is required")
+	}
+	if r.StartDate.IsZero() || r.EndDate.IsZero() {
+		return errors.New("start date and end date are required")
+	}
+	if r.EndDate.Before(r.StartDate) {
+		return errors.New("end date cannot be before start date")
+	}
+	return nil
+}
+
+type MarkPayoutPaidRequest struct {
+	Reference string `json:"reference"`
+}
+
+func (r *MarkPayoutPaidRequest) Validate() error {
+	r.Reference = strings.TrimSpace(r.Reference)
+	if r.Reference == "" {
+		return errors.New("payment reference is required")
+	}
+	if len(r.Reference) > 100 {
+		return errors.New("payment reference is too long")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package validations
+
+import (
+	"errors"
+	"strings"
+
+	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
+)
+
+type StartingPointRequest struct {
+	Name      string   `json:"name"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+func (r *StartingPointRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return errors.New("starting point name is required")
+	}
+	if len(r.Name) > 150 {
+		return errors.New("starting point name is too long")
+	}
+	if r.Latitude == nil || r.Longitude == nil {
+		return errors.New("latitude and longitude are required")
+	}
+	if !utils.ValidCoordinates(*r.Latitude, *r.Longitude) {
+		return errors.New("invalid latitude or longitude")
+	}
+	return nil
+}
+
+type TABandInput struct {
+	UpToKm float64 `json:"up_to_km"`
+	Amount int64   `json:"amount"`
+}
+
+type TAPolicyRequest struct {
+	TransportType string        `json:"transport_type"`
+	Mode          string        `json:"mode"`
+	RatePerKm     int64         `json:"rate_per_km"`
+	MinAmount     int64         `json:"min_amount"`
+	MaxAmount     int64         `json:"max_amount"`
+	RoundTrip     bool          `json:"round_trip"`
+	Bands         []TABandInput `json:"bands"`
+}
+
+func (r *TAPolicyRequest) Validate() error {
+	if r.TransportType != models.TransportOwn && !isValidTransportType(r.TransportType) {
+		return errors.New("invalid transport type")
+	}
+
+	switch r.Mode {
+	case models.TARateModePerKm:
+		if r.RatePerKm <= 0 {
+			return errors.New("rate per km must be greater than zero")
+		}
+		if len(r.Bands) != 0 {
+			return errors.New("bands are only used in band mode")
+		}
+	case models.TARateModeBand:
+		if len(r.Bands) == 0 {
+			return errors.New("at least one distance band is required")
+		}
+		for i, band := range r.Bands {
+			if band.UpToKm <= 0 || band.Amount < 0 {
+				return errors.New("bands need a positive distance and a non-negative amount")
+			}
+			if i > 0 && band.UpToKm <= r.Bands[i-1].UpToKm {
+				return errors.New("bands must be in increasing order of distance")
+			}
+		}
+	default:
+		return errors.New("mode must be per_km or band")
+	}
+
+	if r.MinAmount < 0 || r.MaxAmount < 0 {
+		return errors.New("amount limits cannot be negative")
+	}
+	if r.MaxAmount > 0 && r.MaxAmount < r.MinAmount {
+		return errors.New("max amount cannot be below min amount")
+	}
+	return nil
+}
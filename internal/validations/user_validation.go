@@ -3,7 +3,9 @@ package validations
 import (
 	"errors"
 	"event-management-backend/internal/domain/models"
+	"event-management-backend/internal/utils"
 	"regexp"
+	"strings"
 )
 
 var (
@@ -39,10 +41,7 @@ func (r *CreateUserRequest) Validate() error {
     if r.Role == models.RoleAdmin && r.AdminRoleID == nil {
 		return errors.New("admin role ID is required for administrative accounts")
 	}
-	if len(r.Password) < 4 {
-		return errors.New("password must be at least 4 characters")
-	}
-	return nil
+	return utils.CheckPasswordStrength(r.Password, r.Phone)
 }
 
 //
@@ -118,4 +117,37 @@ func (r *UpdateUserClearanceRequest) Validate() error {
         return errors.New("admin role ID is required for administrative accounts")
     }
     return nil
-}
\ No newline at end of file
+}
+var (
+	ifscRegex    = regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`)
+	accountRegex = regexp.MustCompile(`^[0-9]{9,18}$`)
+)
+
+// UpdateBankDetailsRequest sets the account wages are paid into
+type UpdateBankDetailsRequest struct {
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+	IFSC          string `json:"ifsc"`
+	BankName      string `json:"bank_name"`
+}
+
+func (r *UpdateBankDetailsRequest) Validate() error {
+	r.AccountName = strings.TrimSpace(r.AccountName)
+	r.AccountNumber = strings.TrimSpace(r.AccountNumber)
+	r.IFSC = strings.ToUpper(strings.TrimSpace(r.IFSC))
+	r.BankName = strings.TrimSpace(r.BankName)
+
+	if r.AccountName == "" || len(r.AccountName) > 150 {
+		return errors.New("account holder name is required")
+	}
+	if !accountRegex.MatchString(r.AccountNumber) {
+		return errors.New("account number must be 9 to 18 digits")
+	}
+	if !ifscRegex.MatchString(r.IFSC) {
+		return errors.New("invalid IFSC code")
+	}
+	if len(r.BankName) > 100 {
+		return errors.New("bank name is too long")
+	}
+	return nil
+}
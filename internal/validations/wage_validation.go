@@ -1,6 +1,12 @@
 package validations
 
-import "errors"
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"event-management-backend/internal/domain/models"
+)
 
 type UpdateWageRequest struct {
 	TAAmount    int64 `json:"ta_amount"`
@@ -19,4 +25,70 @@ func (r *UpdateWageRequest) Validate() error {
 		return errors.New("fine amount cannot be negative")
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+type ScheduleRoleWageRequest struct {
+	Wage          int64     `json:"wage"`
+	EffectiveFrom time.Time `json:"effective_from"`
+}
+
+func (r *ScheduleRoleWageRequest) Validate() error {
+	if r.Wage <= 0 {
+		return errors.New("wage must be greater than zero")
+	}
+	if r.EffectiveFrom.IsZero() {
+		return errors.New("effective date is required")
+	}
+	return nil
+}
+
+type WageTierRequest struct {
+	Role             string `json:"role"`
+	MinCompletedWork uint   `json:"min_completed_work"`
+	Increment        int64  `json:"increment"`
+}
+
+func (r *WageTierRequest) Validate() error {
+	r.Role = strings.ToLower(strings.TrimSpace(r.Role))
+	if !models.IsStaffRole(r.Role) {
+		return errors.New("invalid role")
+	}
+	if r.MinCompletedWork == 0 {
+		return errors.New("completed work threshold must be greater than zero")
+	}
+	if r.Increment <= 0 {
+		return errors.New("increment must be greater than zero")
+	}
+	return nil
+}
+
+type WageOverrideRequest struct {
+	Type      string     `json:"type"`
+	Value     int64      `json:"value"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Reason    string     `json:"reason"`
+}
+
+func (r *WageOverrideRequest) Validate() error {
+	switch r.Type {
+	case models.WageOverrideAmount:
+		if r.Value <= 0 {
+			return errors.New("override amount must be greater than zero")
+		}
+	case models.WageOverridePercent:
+		if r.Value == 0 || r.Value < -90 || r.Value > 500 {
+			return errors.New("override percent must be between -90 and 500 and not zero")
+		}
+	default:
+		return errors.New("override type must be amount or percent")
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if r.Reason == "" {
+		return errors.New("reason is required")
+	}
+	if len(r.Reason) > 255 {
+		return errors.New("reason is too long")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"fmt"
+
+	"event-management-backend/internal/config"
+	"event-management-backend/internal/domain/models"
+
+	"gorm.io/gorm"
+)
+
+// legacyRoleColumns are the fixed per-role slot columns events used to carry
+// before staffing moved to event_role_requirements.
+var legacyRoleColumns = []struct {
+	Role      string
+	Required  string
+	Remaining string
+}{
+	{models.RoleCaptain, "required_captains", "remaining_captains"},
+	{models.RoleSubCaptain, "required_sub_captains", "remaining_sub_captains"},
+	{models.RoleMainBoy, "required_main_boys", "remaining_main_boys"},
+	{models.RoleJuniorBoy, "required_juniors", "remaining_juniors"},
+}
+
+// migrateEventRoleColumns copies the legacy slot columns into
+// event_role_requirements and drops them. Safe to run repeatedly.
+func migrateEventRoleColumns() error {
+	return config.DB.Transaction(func(tx *gorm.DB) error {
+		m := tx.Migrator()
+
+		for _, col := range legacyRoleColumns {
+			if !m.HasColumn("events", col.Required) {
+				continue
+			}
+
+			if err := tx.Exec(fmt.Sprintf(`
+				INSERT INTO event_role_requirements (event_id, role, required, remaining, created_at, updated_at)
+				SELECT id, ?, %[1]s, %[2]s, NOW(), NOW()
+				FROM events
+				WHERE %[1]s > 0
+				ON CONFLICT (event_id, role) DO NOTHING
+			`, col.Required, col.Remaining), col.Role).Error; err != nil {
+				return err
+			}
+
+			if err := m.DropColumn("events", col.Required); err != nil {
+				return err
+			}
+			if m.HasColumn("events", col.Remaining) {
+				if err := m.DropColumn("events", col.Remaining); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func Migrate() error {
-	return config.DB.AutoMigrate(
+	if err := config.DB.AutoMigrate(
 		&models.User{},
 		&models.RoleWage{},
 		&models.RefreshToken{},
@@ -15,5 +15,56 @@ func Migrate() error {
 		&models.AdminRole{},
 		&models.Permission{},
 		&models.SystemSetting{},
-	)
+		&models.BookingCancellation{},
+		&models.Waitlist{},
+		&models.EventRoleRequirement{},
+		&models.EventSeries{},
+		&models.EventTemplate{},
+		&models.EventTemplateRole{},
+		&models.EventLifecycleLog{},
+		&models.CheckInAttempt{},
+		&models.PayrollPeriod{},
+		&models.Payout{},
+		&models.PayoutItem{},
+		&models.RoleWageRevision{},
+		&models.WageTier{},
+		&models.WageChangeLog{},
+		&models.WageOverride{},
+		&models.StartingPoint{},
+		&models.TAPolicy{},
+		&models.TABand{},
+		&models.AdjustmentRule{},
+		&models.BookingAdjustment{},
+		&models.Advance{},
+		&models.AdvanceRepayment{},
+		&models.SecurityEvent{},
+		&models.OTPCode{},
+		&models.PasswordResetToken{},
+		&models.AuthRequest{},
+	); err != nil {
+		return err
+	}
+
+	// overrides keep expired rows now, so user_id is no longer unique
+	if err := config.DB.Exec("DROP INDEX IF EXISTS idx_wage_overrides_user_id").Error; err != nil {
+		return err
+	}
+
+	// overrides from before starts_at existed took effect when created
+	if err := config.DB.Exec(
+		"UPDATE wage_overrides SET starts_at = DATE_TRUNC('day', created_at) WHERE starts_at > created_at",
+	).Error; err != nil {
+		return err
+	}
+
+	// replaced by the partial idx_event_templates_name_live
+	if err := config.DB.Exec("DROP INDEX IF EXISTS idx_event_templates_name").Error; err != nil {
+		return err
+	}
+
+	if err := backfillRefreshTokenFamilies(); err != nil {
+		return err
+	}
+
+	return migrateEventRoleColumns()
 }
@@ -0,0 +1,12 @@
+package migrations
+
+import "event-management-backend/internal/config"
+
+// backfillRefreshTokenFamilies makes every token issued before rotation the
+// start of its own family, so existing sessions keep working.
+func backfillRefreshTokenFamilies() error {
+	return config.DB.Exec(`
+		UPDATE refresh_tokens SET family_id = id
+		WHERE family_id IS NULL OR family_id = 0
+	`).Error
+}